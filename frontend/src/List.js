@@ -129,6 +129,8 @@ export default function ItemList(props) {
             switch (res.errorCode) {
               case 340011:
                 return showFailureMsg("Item already in favourites, find something else!")
+              case 340012:
+                return showFailureMsg("This item is no longer available, find something else!")
               default:
                 return showFailureMsg(
                   "Something went wrong, please try again later!"
@@ -175,8 +177,14 @@ export default function ItemList(props) {
                 }}>
                   <Row>
                     <Col span={19}>
-                      <p>{item.name}</p>
-                      <p>${item.price*1.0/100000.0}</p>
+                      {item.errorCode ? (
+                        <p>{item.unavailable ? "This item is no longer available" : "Could not load this item, please refresh"}</p>
+                      ) : (
+                        <>
+                          <p>{item.name}</p>
+                          <p>${item.price*1.0/100000.0}</p>
+                        </>
+                      )}
                     </Col>
                     <Col span={2}>
                       <Button
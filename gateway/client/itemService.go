@@ -16,8 +16,23 @@ import (
 
 const (
 	addFavClient     = "ItemServiceClient.AddFav"
+	updateFavClient  = "ItemServiceClient.UpdateFav"
 	deleteFavClient  = "ItemServiceClient.DeleteFavClient"
 	getFavListClient = "ItemServiceClient.GetFavListClient"
+
+	getItemPriceHistoryClient = "ItemServiceClient.GetItemPriceHistoryClient"
+	createCollectionClient    = "ItemServiceClient.CreateCollectionClient"
+	listCollectionsClient     = "ItemServiceClient.ListCollectionsClient"
+	renameCollectionClient    = "ItemServiceClient.RenameCollectionClient"
+	deleteCollectionClient    = "ItemServiceClient.DeleteCollectionClient"
+	moveFavouriteClient       = "ItemServiceClient.MoveFavouriteClient"
+	annotateFavClient         = "ItemServiceClient.AnnotateFavClient"
+	listTagsClient            = "ItemServiceClient.ListTagsClient"
+	batchAddFavClient         = "ItemServiceClient.BatchAddFavClient"
+	batchDeleteFavClient      = "ItemServiceClient.BatchDeleteFavClient"
+	deleteAllFavouritesClient = "ItemServiceClient.DeleteAllFavouritesClient"
+	exportFavouritesClient    = "ItemServiceClient.ExportFavouritesClient"
+	importFavouritesClient    = "ItemServiceClient.ImportFavouritesClient"
 )
 
 // ItemServiceClient serves as a wrapper for the grpc client to the item service grpc server
@@ -74,6 +89,16 @@ func (i *ItemServiceClient) AddFav(ctx context.Context, req *proto.AddFavReq) (*
 	return i.client.AddFav(ctx, req)
 }
 
+// UpdateFav calls the item service's method with the defined UpdateFavReq
+func (i *ItemServiceClient) UpdateFav(ctx context.Context, req *proto.UpdateFavReq) (*proto.UpdateFavRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, updateFavClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.UpdateFav(ctx, req)
+}
+
 // DeleteFav calls the item service's method with the defined DeleteFavReq
 func (i *ItemServiceClient) DeleteFav(ctx context.Context, req *proto.DeleteFavReq) (*proto.DeleteFavRes, error) {
 	// start span from context
@@ -94,6 +119,137 @@ func (i *ItemServiceClient) GetFavList(ctx context.Context, req *proto.GetFavLis
 	return i.client.GetFavList(ctx, req)
 }
 
+// GetItemPriceHistory calls the item service's method with the defined GetItemPriceHistoryReq
+func (i *ItemServiceClient) GetItemPriceHistory(ctx context.Context, req *proto.GetItemPriceHistoryReq) (*proto.GetItemPriceHistoryRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, getItemPriceHistoryClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.GetItemPriceHistory(ctx, req)
+}
+
+// CreateCollection calls the item service's method with the defined CreateCollectionReq
+func (i *ItemServiceClient) CreateCollection(ctx context.Context, req *proto.CreateCollectionReq) (*proto.CreateCollectionRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, createCollectionClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.CreateCollection(ctx, req)
+}
+
+// ListCollections calls the item service's method with the defined ListCollectionsReq
+func (i *ItemServiceClient) ListCollections(ctx context.Context, req *proto.ListCollectionsReq) (*proto.ListCollectionsRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, listCollectionsClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.ListCollections(ctx, req)
+}
+
+// RenameCollection calls the item service's method with the defined RenameCollectionReq
+func (i *ItemServiceClient) RenameCollection(ctx context.Context, req *proto.RenameCollectionReq) (*proto.RenameCollectionRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, renameCollectionClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.RenameCollection(ctx, req)
+}
+
+// DeleteCollection calls the item service's method with the defined DeleteCollectionReq
+func (i *ItemServiceClient) DeleteCollection(ctx context.Context, req *proto.DeleteCollectionReq) (*proto.DeleteCollectionRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, deleteCollectionClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.DeleteCollection(ctx, req)
+}
+
+// MoveFavourite calls the item service's method with the defined MoveFavouriteReq
+func (i *ItemServiceClient) MoveFavourite(ctx context.Context, req *proto.MoveFavouriteReq) (*proto.MoveFavouriteRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, moveFavouriteClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.MoveFavourite(ctx, req)
+}
+
+// AnnotateFav calls the item service's method with the defined AnnotateFavReq
+func (i *ItemServiceClient) AnnotateFav(ctx context.Context, req *proto.AnnotateFavReq) (*proto.AnnotateFavRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, annotateFavClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.AnnotateFav(ctx, req)
+}
+
+// ListTags calls the item service's method with the defined ListTagsReq
+func (i *ItemServiceClient) ListTags(ctx context.Context, req *proto.ListTagsReq) (*proto.ListTagsRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, listTagsClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.ListTags(ctx, req)
+}
+
+// BatchAddFav calls the item service's method with the defined BatchAddFavReq
+func (i *ItemServiceClient) BatchAddFav(ctx context.Context, req *proto.BatchAddFavReq) (*proto.BatchAddFavRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, batchAddFavClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.BatchAddFav(ctx, req)
+}
+
+// BatchDeleteFav calls the item service's method with the defined BatchDeleteFavReq
+func (i *ItemServiceClient) BatchDeleteFav(ctx context.Context, req *proto.BatchDeleteFavReq) (*proto.BatchDeleteFavRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, batchDeleteFavClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.BatchDeleteFav(ctx, req)
+}
+
+// DeleteAllFavourites calls the item service's method with the defined DeleteAllFavouritesReq
+func (i *ItemServiceClient) DeleteAllFavourites(ctx context.Context, req *proto.DeleteAllFavouritesReq) (*proto.DeleteAllFavouritesRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, deleteAllFavouritesClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.DeleteAllFavourites(ctx, req)
+}
+
+// ExportFavourites calls the item service's method with the defined ExportFavouritesReq, and returns the stream of the export file
+// The span ends when the stream is opened, as the stream is read by the caller.
+func (i *ItemServiceClient) ExportFavourites(ctx context.Context, req *proto.ExportFavouritesReq) (proto.ItemService_ExportFavouritesClient, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, exportFavouritesClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.ExportFavourites(ctx, req)
+}
+
+// ImportFavourites calls the item service's method with the defined ImportFavouritesReq
+func (i *ItemServiceClient) ImportFavourites(ctx context.Context, req *proto.ImportFavouritesReq) (*proto.ImportFavouritesRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, importFavouritesClient)
+	i.addSpanTags(span)
+	defer span.Finish()
+
+	return i.client.ImportFavourites(ctx, req)
+}
+
 func (i *ItemServiceClient) addSpanTags(span ot.Span) {
 	span.SetTag(tracing.SpanKind, tracing.SpanKindClient)
 	span.SetTag(tracing.Component, tracing.ComponentGrpc)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	config "gateway/config"
 	"gateway/constants"
+	"gateway/keyring"
 	proto "gateway/proto"
 	"gateway/tracing"
 
@@ -14,11 +15,18 @@ import (
 )
 
 const (
-	component    = "gin"
-	peerService  = "gateway"
-	spanKind     = "client"
-	signupClient = "gateway.SignupClient"
-	loginClient  = "gateway.LoginClient"
+	component       = "gin"
+	peerService     = "gateway"
+	spanKind        = "client"
+	signupClient    = "gateway.SignupClient"
+	loginClient     = "gateway.LoginClient"
+	deleteClient    = "gateway.DeleteAccountClient"
+	refreshClient   = "gateway.RefreshSessionClient"
+	revokeClient    = "gateway.RevokeSessionClient"
+	revokeAllClient = "gateway.RevokeAllSessionsClient"
+	checkClient     = "gateway.CheckSessionClient"
+	validateClient  = "gateway.ValidateTokenClient"
+	getJWKSClient   = "gateway.GetJWKSClient"
 )
 
 // UserServiceClient serves as a wrapper for the grpc client to the item service grpc server
@@ -87,6 +95,104 @@ func (u *UserServiceClient) Login(ctx context.Context, req *proto.LoginReq) (*pr
 	return u.client.Login(ctx, req)
 }
 
+// DeleteAccount calls the user service's method with the defined DeleteAccountReq
+func (u *UserServiceClient) DeleteAccount(ctx context.Context, req *proto.DeleteAccountReq) (*proto.DeleteAccountRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, deleteClient)
+	u.addSpanTags(span)
+	defer span.Finish()
+
+	// send the request to user service
+	return u.client.DeleteAccount(ctx, req)
+}
+
+// RefreshSession calls the user service's method with the defined RefreshSessionReq
+func (u *UserServiceClient) RefreshSession(ctx context.Context, req *proto.RefreshSessionReq) (*proto.RefreshSessionRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, refreshClient)
+	u.addSpanTags(span)
+	defer span.Finish()
+
+	// send the request to user service
+	return u.client.RefreshSession(ctx, req)
+}
+
+// RevokeSession calls the user service's method with the defined RevokeSessionReq
+func (u *UserServiceClient) RevokeSession(ctx context.Context, req *proto.RevokeSessionReq) (*proto.RevokeSessionRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, revokeClient)
+	u.addSpanTags(span)
+	defer span.Finish()
+
+	// send the request to user service
+	return u.client.RevokeSession(ctx, req)
+}
+
+// RevokeAllSessions calls the user service's method with the defined RevokeAllSessionsReq
+func (u *UserServiceClient) RevokeAllSessions(ctx context.Context, req *proto.RevokeAllSessionsReq) (*proto.RevokeAllSessionsRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, revokeAllClient)
+	u.addSpanTags(span)
+	defer span.Finish()
+
+	// send the request to user service
+	return u.client.RevokeAllSessions(ctx, req)
+}
+
+// CheckSession calls the user service's method with the defined CheckSessionReq
+func (u *UserServiceClient) CheckSession(ctx context.Context, req *proto.CheckSessionReq) (*proto.CheckSessionRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, checkClient)
+	u.addSpanTags(span)
+	defer span.Finish()
+
+	// send the request to user service
+	return u.client.CheckSession(ctx, req)
+}
+
+// ValidateToken calls the user service's method with the defined ValidateTokenReq
+func (u *UserServiceClient) ValidateToken(ctx context.Context, req *proto.ValidateTokenReq) (*proto.ValidateTokenRes, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, validateClient)
+	u.addSpanTags(span)
+	defer span.Finish()
+
+	// send the request to user service
+	return u.client.ValidateToken(ctx, req)
+}
+
+// FetchJWKS calls the user service's GetJWKS method, and returns the public keys that verify tokens
+func (u *UserServiceClient) FetchJWKS(ctx context.Context) (keyring.JWKS, error) {
+	// start span from context
+	span, ctx := ot.StartSpanFromContext(ctx, getJWKSClient)
+	u.addSpanTags(span)
+	defer span.Finish()
+
+	// send the request to user service
+	res, err := u.client.GetJWKS(ctx, &proto.GetJWKSReq{})
+	if err != nil {
+		return keyring.JWKS{}, err
+	}
+	if res.ErrorCode != -1 {
+		return keyring.JWKS{}, fmt.Errorf("%s: %d", constants.ErrorRefreshKeysMsg, res.ErrorCode)
+	}
+
+	jwks := keyring.JWKS{Keys: make([]keyring.JWK, len(res.Keys))}
+	for i, key := range res.Keys {
+		jwks.Keys[i] = keyring.JWK{
+			KeyType:   key.Kty,
+			KeyID:     key.Kid,
+			Algorithm: key.Alg,
+			Use:       key.Use,
+			N:         key.N,
+			E:         key.E,
+			Curve:     key.Crv,
+			X:         key.X,
+		}
+	}
+	return jwks, nil
+}
+
 func (u *UserServiceClient) addSpanTags(span ot.Span) {
 	span.SetTag(tracing.SpanKind, tracing.SpanKindClient)
 	span.SetTag(tracing.Component, tracing.ComponentGrpc)
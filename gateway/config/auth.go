@@ -0,0 +1,21 @@
+package config
+
+// AuthConfig holds config for authenticating requests with the tokens issued by user service
+type AuthConfig struct {
+	// Mode is local to verify tokens at the gateway, or introspect to validate tokens with user service
+	Mode string `mapstructure:"mode"`
+	// CacheTTL is the time in seconds that a session check or introspection result is cached
+	CacheTTL int `mapstructure:"cacheTTL"`
+	// CacheSize is the maximum number of cached results
+	CacheSize int `mapstructure:"cacheSize"`
+	// KeyRefresh is the time in seconds between fetches of user service's public keys
+	KeyRefresh   int    `mapstructure:"keyRefresh"`
+	JWKSEndpoint string `mapstructure:"jwksEndpoint"`
+}
+
+const (
+	// AuthModeLocal verifies tokens at the gateway with the public keys of user service, and checks their sessions with user service
+	AuthModeLocal = "local"
+	// AuthModeIntrospect validates tokens with user service, and caches the results
+	AuthModeIntrospect = "introspect"
+)
@@ -16,6 +16,7 @@ type Config struct {
 	GrpcConfig       GrpcConfig       `mapstructure:"grpc"`
 	PrometheusConfig PrometheusConfig `mapstructure:"prometheus"`
 	JaegerConfig     JaegerConfig     `mapstructure:"jaeger"`
+	AuthConfig       AuthConfig       `mapstructure:"auth"`
 }
 
 // LoadConfig is called in main.go to load all config
@@ -25,6 +26,8 @@ func LoadConfig(logger *zap.Logger) (*Config, error) {
 	viper.AddConfigPath("./config")
 	viper.SetConfigType("yaml")
 	viper.SetConfigName("config")
+	// the trusted proxies depend on the deployment, so they can be set as a comma separated list in the environment
+	viper.BindEnv("trustedProxies", "GATEWAY_TRUSTED_PROXIES")
 
 	err := viper.ReadInConfig()
 	if err != nil {
@@ -20,23 +20,42 @@ type UserServiceConfig struct {
 	Label    string          `mapstructure:label`
 	Host     string          `mapstructure:host`
 	Port     string          `mapstructure:port`
-	Secret   string          `mapstructure:secret`
 	URLGroup string          `mapstructure:urlGroup`
 	APIs     UserServiceAPIs `mapstructure:apis`
-	Expiry   int             `mapstructure:"expiry"`
+	// PurgeAttempts is the number of times the user's favourites are purged from item service before an account deletion fails
+	PurgeAttempts int `mapstructure:"purgeAttempts"`
+	// PurgeBackoff is the wait in milliseconds before the first retry of a purge, doubled after each retry
+	PurgeBackoff int `mapstructure:"purgeBackoff"`
 }
 
 // UserServiceAPIs defines the public APIs to the user service
 type UserServiceAPIs struct {
-	Signup API `mapstructure:signup`
-	Login  API `mapstructure:login`
+	Signup        API `mapstructure:signup`
+	Login         API `mapstructure:login`
+	DeleteAccount API `mapstructure:"deleteAccount"`
+	Refresh       API `mapstructure:"refresh"`
+	Logout        API `mapstructure:"logout"`
+	LogoutAll     API `mapstructure:"logoutAll"`
 }
 
 // ItemServiceAPIs defines the public APIs to the item service
 type ItemServiceAPIs struct {
-	AddFav     API `mapstructure:addFav`
-	DeleteFav  API `mapstructure:deleteFav`
-	GetFavList API `mapstructure:getFavList`
+	AddFav              API `mapstructure:addFav`
+	UpdateFav           API `mapstructure:"updateFav"`
+	DeleteFav           API `mapstructure:deleteFav`
+	GetFavList          API `mapstructure:getFavList`
+	GetItemPriceHistory API `mapstructure:"getItemPriceHistory"`
+	CreateCollection    API `mapstructure:"createCollection"`
+	ListCollections     API `mapstructure:"listCollections"`
+	RenameCollection    API `mapstructure:"renameCollection"`
+	DeleteCollection    API `mapstructure:"deleteCollection"`
+	MoveFav             API `mapstructure:"moveFav"`
+	AnnotateFav         API `mapstructure:"annotateFav"`
+	ListTags            API `mapstructure:"listTags"`
+	BatchAddFav         API `mapstructure:"batchAddFav"`
+	BatchDeleteFav      API `mapstructure:"batchDeleteFav"`
+	ExportFavourites    API `mapstructure:"exportFavourites"`
+	ImportFavourites    API `mapstructure:"importFavourites"`
 }
 
 // API config for a public API
@@ -5,18 +5,54 @@ const (
 
 	// Token string
 	Token = "token"
+	// RefreshToken string
+	RefreshToken = "refreshToken"
+	// SessionID string
+	SessionID = "sessionID"
+	// RetryAfterHeader is the header with the time in seconds until a login can be retried
+	RetryAfterHeader = "Retry-After"
 	// Unauthorized response message
 	Unauthorized = "unauthorized"
 	// BadRequest response message
 	BadRequest = "bad_request"
 	// UserID string
 	UserID = "userID"
+	// Attempt string
+	Attempt = "attempt"
+	// Count string
+	Count = "count"
+	// ErrorCode string
+	ErrorCode = "errorCode"
+	// Deleted string
+	Deleted = "deleted"
 	// ItemID string
 	ItemID = "itemID"
 	// ShopID string
 	ShopID = "shopID"
+	// CollectionID string
+	CollectionID = "collectionID"
+	// Tag string
+	Tag = "tag"
+	// Sort string
+	Sort = "sort"
+	// MinPrice string
+	MinPrice = "minPrice"
+	// MaxPrice string
+	MaxPrice = "maxPrice"
 	// Page string
 	Page = "page"
+	// Format string
+	Format = "format"
+	// File string
+	File = "file"
+	// Cursor string
+	Cursor = "cursor"
+	// PageSize string
+	PageSize = "pageSize"
+	// From string
+	From = "from"
+	// To string
+	To = "to"
 	// Request string
 	Request = "request"
 	// Username string
@@ -19,6 +19,10 @@ const (
 	ErrorUnauthorized = 140111
 	// ErrorTokenInvalid service error code
 	ErrorTokenInvalid = 140112
+	// ErrorSessionRevoked service error code
+	ErrorSessionRevoked = 140113
+	// ErrorNoRefreshToken service error code
+	ErrorNoRefreshToken = 140114
 
 	// 500 errors
 	// server errors
@@ -43,8 +47,26 @@ const (
 
 	// function errors
 
+	// ErrorExportStream service error code
+	ErrorExportStream = 150061
+	// ErrorExportTooLarge service error code
+	ErrorExportTooLarge = 150062
+
+	// ErrorPurgeFavourites service error code
+	ErrorPurgeFavourites = 150071
+
 	// ErrorParseInt service error code
 	ErrorParseInt = 150041
 	// ErrorTypeAssertion service error code
 	ErrorTypeAssertion = 150051
 )
+
+// user service error codes handled by the gateway
+const (
+	// UserServiceSessionRevoked is returned by user service when a token's session was revoked or has expired
+	UserServiceSessionRevoked = 240017
+	// UserServiceTokenInvalid is returned by user service when a token's signature or claims are invalid
+	UserServiceTokenInvalid = 240018
+	// UserServiceTooManyLoginAttempts is returned by user service when a login is delayed or locked after failed attempts
+	UserServiceTooManyLoginAttempts = 240019
+)
@@ -17,6 +17,10 @@ const (
 	ErrorUnauthorizedMsg = "error_user_unauthorized"
 	// ErrorTokenInvalidMsg service error message
 	ErrorTokenInvalidMsg = "error_token_invalid"
+	// ErrorSessionRevokedMsg service error message
+	ErrorSessionRevokedMsg = "error_session_revoked"
+	// ErrorNoRefreshTokenMsg service error message
+	ErrorNoRefreshTokenMsg = "error_no_refresh_token"
 	// ErrorGenerateJWTTokenMsg service error message
 	ErrorGenerateJWTTokenMsg = "error_generate_jwt_token"
 
@@ -25,6 +29,8 @@ const (
 
 	// ErrorServerStartFailMsg service error message
 	ErrorServerStartFailMsg = "error_server_start_fail"
+	// ErrorTrustedProxiesMsg service error message
+	ErrorTrustedProxiesMsg = "error_trusted_proxies"
 	// ErrorUserserviceConnectionMsg service error message
 	ErrorUserserviceConnectionMsg = "error_userservice_connection"
 	// ErrorItemserviceConnectionMsg service error message
@@ -47,6 +53,18 @@ const (
 	ErrorParseIntMsg = "error_parse_int"
 	// ErrorTypeAssertionMsg service error message
 	ErrorTypeAssertionMsg = "error_type_assertion"
+	// ErrorExportStreamMsg service error message
+	ErrorExportStreamMsg = "error_export_stream"
+	// ErrorExportTooLargeMsg service error message
+	ErrorExportTooLargeMsg = "error_export_too_large"
+	// ErrorRevokeSessionsMsg service error message
+	ErrorRevokeSessionsMsg = "error_revoke_sessions"
+	// ErrorPurgeFavouritesMsg service error message
+	ErrorPurgeFavouritesMsg = "error_purge_favourites"
+	// ErrorRefreshKeysMsg service error message
+	ErrorRefreshKeysMsg = "error_refresh_keys"
+	// ErrorIntrospectTokenMsg service error message
+	ErrorIntrospectTokenMsg = "error_introspect_token"
 	// ErrorCreateGRPCChannelMsg service error message
 	ErrorCreateGRPCChannelMsg = "error_create_grpc_channel"
 )
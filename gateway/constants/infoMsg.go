@@ -17,4 +17,12 @@ const (
 	InfoItemServiceRequest = "info_itemservice_request"
 	// InfoUserServiceRequest log info message
 	InfoUserServiceRequest = "info_userservice_request"
+	// InfoAccountDeleted log info message
+	InfoAccountDeleted = "info_account_deleted"
+	// InfoLogout log info message
+	InfoLogout = "info_logout"
+	// InfoLogoutAll log info message
+	InfoLogoutAll = "info_logout_all"
+	// InfoKeysRefreshed log info message
+	InfoKeysRefreshed = "info_keys_refreshed"
 )
@@ -2,7 +2,9 @@ package controllers
 
 import (
 	res "gateway/dto/response"
+	"gateway/keyring"
 	"gateway/tracing"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 	ot "github.com/opentracing/opentracing-go"
@@ -21,3 +23,12 @@ func AddErrorTagsToSpan(span ot.Span, errorCode int32, errorMsg string) {
 	span.SetTag(tracing.ServiceErrorCode, errorCode)
 	span.SetTag(tracing.ServiceErrorMsg, errorMsg)
 }
+
+// JWKSHandler returns the handler for the /.well-known/jwks.json endpoint, which publishes the public keys that verify tokens.
+// The keys are fetched from user service by the key ring, so the handler returns the keys of the last refresh.
+func JWKSHandler(keys *keyring.KeyRing) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, keys.JWKS())
+	}
+}
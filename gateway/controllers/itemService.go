@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"bytes"
+	"fmt"
 	client "gateway/client"
 	"gateway/config"
 	"gateway/constants"
@@ -8,7 +10,9 @@ import (
 	res "gateway/dto/response"
 	metrics "gateway/metrics"
 	proto "gateway/proto"
+	"io"
 	"strconv"
+	"strings"
 
 	ot "github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
@@ -19,10 +23,35 @@ import (
 
 const (
 	addFavHandler     = "gateway.AddFavHandler"
+	updateFavHandler  = "gateway.UpdateFavHandler"
 	deleteFavHandler  = "gateway.DeleteFavHandler"
 	getFavListHandler = "gateway.GetFavListHandler"
+
+	getItemPriceHistoryHandler = "gateway.GetItemPriceHistoryHandler"
+	createCollectionHandler    = "gateway.CreateCollectionHandler"
+	listCollectionsHandler     = "gateway.ListCollectionsHandler"
+	renameCollectionHandler    = "gateway.RenameCollectionHandler"
+	deleteCollectionHandler    = "gateway.DeleteCollectionHandler"
+	moveFavHandler             = "gateway.MoveFavHandler"
+	annotateFavHandler         = "gateway.AnnotateFavHandler"
+	listTagsHandler            = "gateway.ListTagsHandler"
+	batchAddFavHandler         = "gateway.BatchAddFavHandler"
+	batchDeleteFavHandler      = "gateway.BatchDeleteFavHandler"
+	exportFavouritesHandler    = "gateway.ExportFavouritesHandler"
+	importFavouritesHandler    = "gateway.ImportFavouritesHandler"
+
+	// maxImportFileSize is the max size of an uploaded import file in bytes, below the max grpc message size of item service
+	maxImportFileSize = 1 << 20
+	// maxExportFileSize is the max size of an export file in bytes, which is buffered whole before it is sent
+	maxExportFileSize = 16 << 20
 )
 
+// exportContentTypes are the content types of the export file formats.
+var exportContentTypes = map[proto.FileFormat]string{
+	proto.FileFormat_JSON: "application/json",
+	proto.FileFormat_CSV:  "text/csv",
+}
+
 // ItemServiceController is called to handle incoming HTTP requests directed to the item service.
 type ItemServiceController struct {
 	config *config.ItemServiceConfig
@@ -116,9 +145,11 @@ func (i *ItemServiceController) AddFavHandler(c *gin.Context) {
 
 	// construct the request to be made as a grpc client to item service
 	clientAddFavReq := &proto.AddFavReq{
-		UserID: userID,
-		ItemID: itemID,
-		ShopID: shopID,
+		UserID:      userID,
+		ItemID:      itemID,
+		ShopID:      shopID,
+		TargetPrice: addFavReq.TargetPrice,
+		DropPercent: addFavReq.DropPercent,
 	}
 
 	// call item service
@@ -138,12 +169,13 @@ func (i *ItemServiceController) AddFavHandler(c *gin.Context) {
 	c.IndentedJSON(200, clientAddFavRes)
 }
 
-// DeleteFavHandler handles requests to the /item/delete/fav endpoint
-func (i *ItemServiceController) DeleteFavHandler(c *gin.Context) {
+// UpdateFavHandler handles requests to the /item/update/fav endpoint
+func (i *ItemServiceController) UpdateFavHandler(c *gin.Context) {
 	// start tracing span from context
 	span := ot.SpanFromContext(c.Request.Context())
 	i.addSpanTags(span, c)
 	defer span.Finish()
+
 	var errorCodeStr string
 
 	// observe request latency
@@ -168,40 +200,114 @@ func (i *ItemServiceController) DeleteFavHandler(c *gin.Context) {
 		return
 	}
 
-	// retrieve query params
-	itemID, err := strconv.ParseInt(c.Query(constants.ItemID), 10, 64)
+	var updateFavReq req.UpdateFavReq
+	err := c.BindJSON(&updateFavReq)
 	if err != nil {
-		i.logger.Error(
-			constants.ErrorParseIntMsg,
-			zap.String(constants.ItemID, c.Query(constants.ItemID)),
+		i.logger.Info(
+			constants.ErrorInvalidRequestMsg,
 			zap.Error(err),
 		)
-		errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
+		errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
 		// add the resulting error code to the span and send a standard gateway response back to the client
-		SendStandardGatewayResponse(c, span, constants.ErrorParseInt, constants.ErrorParseIntMsg)
+		SendStandardGatewayResponse(c, span, constants.ErrorInvalidRequest, constants.ErrorInvalidRequestMsg)
 		return
 	}
-	shopID, err := strconv.ParseInt(c.Query(constants.ShopID), 10, 64)
+	i.logger.Info(
+		constants.InfoItemServiceRequest,
+		zap.Any(constants.Request, updateFavReq),
+	)
+
+	// construct the request to be made as a grpc client to item service
+	clientUpdateFavReq := &proto.UpdateFavReq{
+		UserID:      userID,
+		ItemID:      updateFavReq.ItemID,
+		ShopID:      updateFavReq.ShopID,
+		TargetPrice: updateFavReq.TargetPrice,
+		DropPercent: updateFavReq.DropPercent,
+	}
+
+	// call item service
+	clientUpdateFavRes, err := i.client.UpdateFav(c.Request.Context(), clientUpdateFavReq)
 	if err != nil {
-		i.logger.Error(
-			constants.ErrorParseIntMsg,
-			zap.String(constants.ShopID, c.Query(constants.ShopID)),
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics label
+	errorCodeStr = strconv.Itoa(int(clientUpdateFavRes.ErrorCode))
+	// add resulting errorCode to span
+	AddErrorTagsToSpan(span, clientUpdateFavRes.ErrorCode, clientUpdateFavRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientUpdateFavRes)
+}
+
+// BatchAddFavHandler handles requests to the /item/batch/add/fav endpoint
+func (i *ItemServiceController) BatchAddFavHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	var batchAddFavReq req.BatchFavReq
+	err := c.BindJSON(&batchAddFavReq)
+	if err != nil {
+		i.logger.Info(
+			constants.ErrorInvalidRequestMsg,
 			zap.Error(err),
 		)
-		errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
+		errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
 		// add the resulting error code to the span and send a standard gateway response back to the client
-		SendStandardGatewayResponse(c, span, constants.ErrorParseInt, constants.ErrorParseIntMsg)
+		SendStandardGatewayResponse(c, span, constants.ErrorInvalidRequest, constants.ErrorInvalidRequestMsg)
 		return
 	}
+	i.logger.Info(
+		constants.InfoItemServiceRequest,
+		zap.Any(constants.Request, batchAddFavReq),
+	)
 
 	// construct the request to be made as a grpc client to item service
-	clientDeleteFavReq := &proto.DeleteFavReq{
+	items := make([]*proto.BatchFavItem, len(batchAddFavReq.Items))
+	for idx, item := range batchAddFavReq.Items {
+		items[idx] = &proto.BatchFavItem{
+			ItemID:      item.ItemID,
+			ShopID:      item.ShopID,
+			TargetPrice: item.TargetPrice,
+			DropPercent: item.DropPercent,
+		}
+	}
+	clientBatchAddFavReq := &proto.BatchAddFavReq{
 		UserID: userID,
-		ItemID: itemID,
-		ShopID: shopID,
+		Items:  items,
 	}
+
 	// call item service
-	clientDeleteFavRes, err := i.client.DeleteFav(c.Request.Context(), clientDeleteFavReq)
+	clientBatchAddFavRes, err := i.client.BatchAddFav(c.Request.Context(), clientBatchAddFavReq)
 	if err != nil {
 		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
 		// add the resulting error code to the span and send a standard gateway response back to the client
@@ -209,20 +315,21 @@ func (i *ItemServiceController) DeleteFavHandler(c *gin.Context) {
 		return
 	}
 
-	// convert error code to string for metrics
-	errorCodeStr = strconv.Itoa(int(clientDeleteFavRes.ErrorCode))
-	// add the resulting error code to the span
-	AddErrorTagsToSpan(span, clientDeleteFavRes.ErrorCode, clientDeleteFavRes.ErrorMsg)
+	// convert error code to string for metrics label
+	errorCodeStr = strconv.Itoa(int(clientBatchAddFavRes.ErrorCode))
+	// add resulting errorCode to span
+	AddErrorTagsToSpan(span, clientBatchAddFavRes.ErrorCode, clientBatchAddFavRes.ErrorMsg)
 	// return response
-	c.IndentedJSON(200, clientDeleteFavRes)
+	c.IndentedJSON(200, clientBatchAddFavRes)
 }
 
-// GetFavListHandler handles requests to the /item/get/list endpoint
-func (i *ItemServiceController) GetFavListHandler(c *gin.Context) {
+// BatchDeleteFavHandler handles requests to the /item/batch/delete/fav endpoint
+func (i *ItemServiceController) BatchDeleteFavHandler(c *gin.Context) {
 	// start tracing span from context
 	span := ot.SpanFromContext(c.Request.Context())
 	i.addSpanTags(span, c)
 	defer span.Finish()
+
 	var errorCodeStr string
 
 	// observe request latency
@@ -247,32 +354,216 @@ func (i *ItemServiceController) GetFavListHandler(c *gin.Context) {
 		return
 	}
 
-	// retrieve query params
-	page, err := strconv.Atoi(c.Query(constants.Page))
+	var batchDeleteFavReq req.BatchFavReq
+	err := c.BindJSON(&batchDeleteFavReq)
 	if err != nil {
-		i.logger.Error(
-			constants.ErrorParseIntMsg,
-			zap.String(constants.Page, c.Query(constants.Page)),
+		i.logger.Info(
+			constants.ErrorInvalidRequestMsg,
 			zap.Error(err),
 		)
-		errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
-		c.JSON(200, res.GatewayResponse{ErrorCode: constants.ErrorParseInt})
+		errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorInvalidRequest, constants.ErrorInvalidRequestMsg)
+		return
+	}
+	i.logger.Info(
+		constants.InfoItemServiceRequest,
+		zap.Any(constants.Request, batchDeleteFavReq),
+	)
+
+	// construct the request to be made as a grpc client to item service
+	items := make([]*proto.BatchFavItem, len(batchDeleteFavReq.Items))
+	for idx, item := range batchDeleteFavReq.Items {
+		items[idx] = &proto.BatchFavItem{
+			ItemID:      item.ItemID,
+			ShopID:      item.ShopID,
+			TargetPrice: item.TargetPrice,
+			DropPercent: item.DropPercent,
+		}
+	}
+	clientBatchDeleteFavReq := &proto.BatchDeleteFavReq{
+		UserID: userID,
+		Items:  items,
+	}
+
+	// call item service
+	clientBatchDeleteFavRes, err := i.client.BatchDeleteFav(c.Request.Context(), clientBatchDeleteFavReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics label
+	errorCodeStr = strconv.Itoa(int(clientBatchDeleteFavRes.ErrorCode))
+	// add resulting errorCode to span
+	AddErrorTagsToSpan(span, clientBatchDeleteFavRes.ErrorCode, clientBatchDeleteFavRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientBatchDeleteFavRes)
+}
+
+// ExportFavouritesHandler handles requests to the /item/export/favs endpoint
+// The user's favourites are downloaded as a file, streamed from item service as it is written.
+// The file is buffered until the stream ends, so that a failure part way is sent as an error instead of a truncated file.
+func (i *ItemServiceController) ExportFavouritesHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	// format is optional, and defaults to json
+	format, ok := parseFileFormat(c.Query(constants.Format))
+	if !ok {
+		errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
+		SendStandardGatewayResponse(c, span, constants.ErrorInvalidRequest, constants.ErrorInvalidRequestMsg)
+		return
+	}
+
+	// call item service
+	stream, err := i.client.ExportFavourites(c.Request.Context(), &proto.ExportFavouritesReq{
+		UserID: userID,
+		Format: format,
+	})
+	var first *proto.ExportFavouritesRes
+	if err == nil {
+		first, err = stream.Recv()
+	}
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics label
+	errorCodeStr = strconv.Itoa(int(first.ErrorCode))
+	// add resulting errorCode to span
+	AddErrorTagsToSpan(span, first.ErrorCode, first.ErrorMsg)
+	if first.ErrorCode != -1 {
+		c.IndentedJSON(200, first)
+		return
+	}
+
+	// read the rest of the file
+	file := bytes.NewBuffer(first.Data)
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err == nil && chunk.ErrorCode != -1 {
+			err = fmt.Errorf("errorCode: %d", chunk.ErrorCode)
+		}
+		if err != nil {
+			i.logger.Error(
+				constants.ErrorExportStreamMsg,
+				zap.Int64(constants.UserID, userID),
+				zap.Error(err),
+			)
+			errorCodeStr = strconv.Itoa(constants.ErrorExportStream)
+			SendStandardGatewayResponse(c, span, constants.ErrorExportStream, constants.ErrorExportStreamMsg)
+			return
+		}
+		if file.Len()+len(chunk.Data) > maxExportFileSize {
+			errorCodeStr = strconv.Itoa(constants.ErrorExportTooLarge)
+			SendStandardGatewayResponse(c, span, constants.ErrorExportTooLarge, constants.ErrorExportTooLargeMsg)
+			return
+		}
+		file.Write(chunk.Data)
+	}
+
+	// return the file
+	extension := strings.ToLower(format.String())
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"favourites.%s\"", extension))
+	c.Data(200, exportContentTypes[format], file.Bytes())
+}
+
+// ImportFavouritesHandler handles requests to the /item/import/favs endpoint
+// The export file is uploaded as the file field of a multipart form.
+func (i *ItemServiceController) ImportFavouritesHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	// format is optional, and defaults to json
+	format, ok := parseFileFormat(c.Query(constants.Format))
+	if !ok {
+		errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
+		SendStandardGatewayResponse(c, span, constants.ErrorInvalidRequest, constants.ErrorInvalidRequestMsg)
 		return
 	}
 
-	if page < 0 {
+	data, err := readUploadedFile(c, constants.File, maxImportFileSize)
+	if err != nil {
+		i.logger.Info(
+			constants.ErrorInvalidRequestMsg,
+			zap.Error(err),
+		)
 		errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
-		c.JSON(200, res.GatewayResponse{ErrorCode: constants.ErrorInvalidRequest})
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorInvalidRequest, constants.ErrorInvalidRequestMsg)
 		return
 	}
 
 	// construct the request to be made as a grpc client to item service
-	clientGetFavListReq := &proto.GetFavListReq{
+	clientImportFavouritesReq := &proto.ImportFavouritesReq{
 		UserID: userID,
-		Page:   int32(page),
+		Format: format,
+		Data:   data,
 	}
+
 	// call item service
-	clientGetFavListRes, err := i.client.GetFavList(c.Request.Context(), clientGetFavListReq)
+	clientImportFavouritesRes, err := i.client.ImportFavourites(c.Request.Context(), clientImportFavouritesReq)
 	if err != nil {
 		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
 		// add the resulting error code to the span and send a standard gateway response back to the client
@@ -280,34 +571,831 @@ func (i *ItemServiceController) GetFavListHandler(c *gin.Context) {
 		return
 	}
 
-	// convert error code to string for metrics
-	errorCodeStr = strconv.Itoa(int(clientGetFavListRes.ErrorCode))
-
-	// add the resulting error code to the span
-	AddErrorTagsToSpan(span, clientGetFavListRes.ErrorCode, clientGetFavListRes.ErrorMsg)
+	// convert error code to string for metrics label
+	errorCodeStr = strconv.Itoa(int(clientImportFavouritesRes.ErrorCode))
+	// add resulting errorCode to span
+	AddErrorTagsToSpan(span, clientImportFavouritesRes.ErrorCode, clientImportFavouritesRes.ErrorMsg)
 	// return response
-	c.IndentedJSON(200, clientGetFavListRes)
+	c.IndentedJSON(200, clientImportFavouritesRes)
 }
 
-// getUserID is a helper function to retrieve the userID set by the auth middleware from the context.
-func (i *ItemServiceController) getUserID(c *gin.Context, span ot.Span) int64 {
-	userIDRaw, exists := c.Get(constants.UserID)
-
-	if !exists {
-		i.logger.Error(constants.ErrorNoUserIDInTokenMsg)
-		SendStandardGatewayResponse(c, span, constants.ErrorNoUserIDInToken, constants.ErrorNoUserIDInTokenMsg)
-		return 0
+// parseFileFormat parses the format of an export or import file, which is json if the format is empty.
+func parseFileFormat(format string) (proto.FileFormat, bool) {
+	if format == "" {
+		return proto.FileFormat_JSON, true
 	}
+	v, ok := proto.FileFormat_value[strings.ToUpper(format)]
+	return proto.FileFormat(v), ok
+}
 
-	userID, err := strconv.ParseInt(userIDRaw.(string), 10, 64)
+// readUploadedFile reads the file uploaded as the field of a multipart form.
+// It returns an error if there is no file, or it is larger than maxSize bytes.
+func readUploadedFile(c *gin.Context, field string, maxSize int64) ([]byte, error) {
+	header, err := c.FormFile(field)
 	if err != nil {
-		i.logger.Error(constants.ErrorParseIntMsg, zap.Error(err))
-		SendStandardGatewayResponse(c, span, constants.ErrorGetUserIDFromToken, constants.ErrorGetUserIDFromTokenMsg)
-		return 0
+		return nil, err
 	}
-	return userID
+	if header.Size > maxSize {
+		return nil, fmt.Errorf("file size %d exceeds %d", header.Size, maxSize)
+	}
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return io.ReadAll(file)
 }
 
-func (i *ItemServiceController) addSpanTags(span ot.Span, c *gin.Context) {
-	// TODO; add additional tags if needed
+// DeleteFavHandler handles requests to the /item/delete/fav endpoint
+func (i *ItemServiceController) DeleteFavHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	// retrieve query params
+	itemID, err := strconv.ParseInt(c.Query(constants.ItemID), 10, 64)
+	if err != nil {
+		i.logger.Error(
+			constants.ErrorParseIntMsg,
+			zap.String(constants.ItemID, c.Query(constants.ItemID)),
+			zap.Error(err),
+		)
+		errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorParseInt, constants.ErrorParseIntMsg)
+		return
+	}
+	shopID, err := strconv.ParseInt(c.Query(constants.ShopID), 10, 64)
+	if err != nil {
+		i.logger.Error(
+			constants.ErrorParseIntMsg,
+			zap.String(constants.ShopID, c.Query(constants.ShopID)),
+			zap.Error(err),
+		)
+		errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorParseInt, constants.ErrorParseIntMsg)
+		return
+	}
+
+	// construct the request to be made as a grpc client to item service
+	clientDeleteFavReq := &proto.DeleteFavReq{
+		UserID: userID,
+		ItemID: itemID,
+		ShopID: shopID,
+	}
+	// call item service
+	clientDeleteFavRes, err := i.client.DeleteFav(c.Request.Context(), clientDeleteFavReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientDeleteFavRes.ErrorCode))
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientDeleteFavRes.ErrorCode, clientDeleteFavRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientDeleteFavRes)
+}
+
+// GetFavListHandler handles requests to the /item/get/list endpoint
+func (i *ItemServiceController) GetFavListHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	// retrieve query params
+	// collectionID, shopID, minPrice and maxPrice are optional, favourites are not filtered by them without them
+	// pageSize is optional, and lists with a cursor when it is set
+	var collectionID, shopID, minPrice, maxPrice, pageSize int64
+	var err error
+	params := []struct {
+		key   string
+		value *int64
+	}{
+		{constants.CollectionID, &collectionID},
+		{constants.ShopID, &shopID},
+		{constants.MinPrice, &minPrice},
+		{constants.MaxPrice, &maxPrice},
+		{constants.PageSize, &pageSize},
+	}
+	for _, param := range params {
+		key := param.key
+		if c.Query(key) == "" {
+			continue
+		}
+		*param.value, err = strconv.ParseInt(c.Query(key), 10, 64)
+		if err != nil {
+			i.logger.Error(
+				constants.ErrorParseIntMsg,
+				zap.String(key, c.Query(key)),
+				zap.Error(err),
+			)
+			errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
+			c.JSON(200, res.GatewayResponse{ErrorCode: constants.ErrorParseInt})
+			return
+		}
+	}
+
+	// the page number is required unless listing with a cursor
+	cursor := c.Query(constants.Cursor)
+	var page int
+	if cursor == "" && pageSize == 0 {
+		page, err = strconv.Atoi(c.Query(constants.Page))
+		if err != nil {
+			i.logger.Error(
+				constants.ErrorParseIntMsg,
+				zap.String(constants.Page, c.Query(constants.Page)),
+				zap.Error(err),
+			)
+			errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
+			c.JSON(200, res.GatewayResponse{ErrorCode: constants.ErrorParseInt})
+			return
+		}
+
+		if page < 0 {
+			errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
+			c.JSON(200, res.GatewayResponse{ErrorCode: constants.ErrorInvalidRequest})
+			return
+		}
+	}
+
+	// sort is optional, favourites are listed from the most recently added without it
+	var sort proto.FavSort
+	if c.Query(constants.Sort) != "" {
+		v, ok := proto.FavSort_value[strings.ToUpper(c.Query(constants.Sort))]
+		if !ok {
+			errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
+			c.JSON(200, res.GatewayResponse{ErrorCode: constants.ErrorInvalidRequest})
+			return
+		}
+		sort = proto.FavSort(v)
+	}
+
+	// construct the request to be made as a grpc client to item service
+	// tag is optional, favourites with any tags are listed without it
+	clientGetFavListReq := &proto.GetFavListReq{
+		UserID:       userID,
+		Page:         int32(page),
+		CollectionID: collectionID,
+		Tag:          c.Query(constants.Tag),
+		Sort:         sort,
+		ShopID:       shopID,
+		MinPrice:     minPrice,
+		MaxPrice:     maxPrice,
+		Cursor:       cursor,
+		PageSize:     int32(pageSize),
+	}
+	// call item service
+	clientGetFavListRes, err := i.client.GetFavList(c.Request.Context(), clientGetFavListReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientGetFavListRes.ErrorCode))
+
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientGetFavListRes.ErrorCode, clientGetFavListRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientGetFavListRes)
+}
+
+// GetItemPriceHistoryHandler handles requests to the /item/get/price/history endpoint
+func (i *ItemServiceController) GetItemPriceHistoryHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	// retrieve query params
+	itemID, err := strconv.ParseInt(c.Query(constants.ItemID), 10, 64)
+	if err != nil {
+		i.logger.Error(
+			constants.ErrorParseIntMsg,
+			zap.String(constants.ItemID, c.Query(constants.ItemID)),
+			zap.Error(err),
+		)
+		errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorParseInt, constants.ErrorParseIntMsg)
+		return
+	}
+	shopID, err := strconv.ParseInt(c.Query(constants.ShopID), 10, 64)
+	if err != nil {
+		i.logger.Error(
+			constants.ErrorParseIntMsg,
+			zap.String(constants.ShopID, c.Query(constants.ShopID)),
+			zap.Error(err),
+		)
+		errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorParseInt, constants.ErrorParseIntMsg)
+		return
+	}
+
+	// from and to are optional unix timestamps in seconds
+	var from, to int64
+	if c.Query(constants.From) != "" {
+		from, err = strconv.ParseInt(c.Query(constants.From), 10, 64)
+		if err != nil {
+			i.logger.Error(
+				constants.ErrorParseIntMsg,
+				zap.String(constants.From, c.Query(constants.From)),
+				zap.Error(err),
+			)
+			errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
+			// add the resulting error code to the span and send a standard gateway response back to the client
+			SendStandardGatewayResponse(c, span, constants.ErrorParseInt, constants.ErrorParseIntMsg)
+			return
+		}
+	}
+	if c.Query(constants.To) != "" {
+		to, err = strconv.ParseInt(c.Query(constants.To), 10, 64)
+		if err != nil {
+			i.logger.Error(
+				constants.ErrorParseIntMsg,
+				zap.String(constants.To, c.Query(constants.To)),
+				zap.Error(err),
+			)
+			errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
+			// add the resulting error code to the span and send a standard gateway response back to the client
+			SendStandardGatewayResponse(c, span, constants.ErrorParseInt, constants.ErrorParseIntMsg)
+			return
+		}
+	}
+
+	// construct the request to be made as a grpc client to item service
+	clientGetItemPriceHistoryReq := &proto.GetItemPriceHistoryReq{
+		ItemID: itemID,
+		ShopID: shopID,
+		From:   from,
+		To:     to,
+	}
+	// call item service
+	clientGetItemPriceHistoryRes, err := i.client.GetItemPriceHistory(c.Request.Context(), clientGetItemPriceHistoryReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientGetItemPriceHistoryRes.ErrorCode))
+
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientGetItemPriceHistoryRes.ErrorCode, clientGetItemPriceHistoryRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientGetItemPriceHistoryRes)
+}
+
+// getUserID is a helper function to retrieve the userID set by the auth middleware from the context.
+func (i *ItemServiceController) getUserID(c *gin.Context, span ot.Span) int64 {
+	userIDRaw, exists := c.Get(constants.UserID)
+
+	if !exists {
+		i.logger.Error(constants.ErrorNoUserIDInTokenMsg)
+		SendStandardGatewayResponse(c, span, constants.ErrorNoUserIDInToken, constants.ErrorNoUserIDInTokenMsg)
+		return 0
+	}
+
+	userID, err := strconv.ParseInt(userIDRaw.(string), 10, 64)
+	if err != nil {
+		i.logger.Error(constants.ErrorParseIntMsg, zap.Error(err))
+		SendStandardGatewayResponse(c, span, constants.ErrorGetUserIDFromToken, constants.ErrorGetUserIDFromTokenMsg)
+		return 0
+	}
+	return userID
+}
+
+func (i *ItemServiceController) addSpanTags(span ot.Span, c *gin.Context) {
+	// TODO; add additional tags if needed
+}
+
+// CreateCollectionHandler handles requests to the /item/create/collection endpoint
+func (i *ItemServiceController) CreateCollectionHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	var createCollectionReq req.CreateCollectionReq
+	err := c.BindJSON(&createCollectionReq)
+	if err != nil {
+		i.logger.Info(
+			constants.ErrorInvalidRequestMsg,
+			zap.Error(err),
+		)
+		errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorInvalidRequest, constants.ErrorInvalidRequestMsg)
+		return
+	}
+	i.logger.Info(
+		constants.InfoItemServiceRequest,
+		zap.Any(constants.Request, createCollectionReq),
+	)
+
+	// construct the request to be made as a grpc client to item service
+	clientCreateCollectionReq := &proto.CreateCollectionReq{
+		UserID: userID,
+		Name:   createCollectionReq.Name,
+	}
+	// call item service
+	clientCreateCollectionRes, err := i.client.CreateCollection(c.Request.Context(), clientCreateCollectionReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientCreateCollectionRes.ErrorCode))
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientCreateCollectionRes.ErrorCode, clientCreateCollectionRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientCreateCollectionRes)
+}
+
+// ListCollectionsHandler handles requests to the /item/get/collections endpoint
+func (i *ItemServiceController) ListCollectionsHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	// construct the request to be made as a grpc client to item service
+	clientListCollectionsReq := &proto.ListCollectionsReq{
+		UserID: userID,
+	}
+	// call item service
+	clientListCollectionsRes, err := i.client.ListCollections(c.Request.Context(), clientListCollectionsReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientListCollectionsRes.ErrorCode))
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientListCollectionsRes.ErrorCode, clientListCollectionsRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientListCollectionsRes)
+}
+
+// RenameCollectionHandler handles requests to the /item/rename/collection endpoint
+func (i *ItemServiceController) RenameCollectionHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	var renameCollectionReq req.RenameCollectionReq
+	err := c.BindJSON(&renameCollectionReq)
+	if err != nil {
+		i.logger.Info(
+			constants.ErrorInvalidRequestMsg,
+			zap.Error(err),
+		)
+		errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorInvalidRequest, constants.ErrorInvalidRequestMsg)
+		return
+	}
+	i.logger.Info(
+		constants.InfoItemServiceRequest,
+		zap.Any(constants.Request, renameCollectionReq),
+	)
+
+	// construct the request to be made as a grpc client to item service
+	clientRenameCollectionReq := &proto.RenameCollectionReq{
+		UserID:       userID,
+		CollectionID: renameCollectionReq.CollectionID,
+		Name:         renameCollectionReq.Name,
+	}
+	// call item service
+	clientRenameCollectionRes, err := i.client.RenameCollection(c.Request.Context(), clientRenameCollectionReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientRenameCollectionRes.ErrorCode))
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientRenameCollectionRes.ErrorCode, clientRenameCollectionRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientRenameCollectionRes)
+}
+
+// DeleteCollectionHandler handles requests to the /item/delete/collection endpoint
+func (i *ItemServiceController) DeleteCollectionHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	// retrieve query params
+	collectionID, err := strconv.ParseInt(c.Query(constants.CollectionID), 10, 64)
+	if err != nil {
+		i.logger.Error(
+			constants.ErrorParseIntMsg,
+			zap.String(constants.CollectionID, c.Query(constants.CollectionID)),
+			zap.Error(err),
+		)
+		errorCodeStr = strconv.Itoa(constants.ErrorParseInt)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorParseInt, constants.ErrorParseIntMsg)
+		return
+	}
+
+	// construct the request to be made as a grpc client to item service
+	clientDeleteCollectionReq := &proto.DeleteCollectionReq{
+		UserID:       userID,
+		CollectionID: collectionID,
+	}
+	// call item service
+	clientDeleteCollectionRes, err := i.client.DeleteCollection(c.Request.Context(), clientDeleteCollectionReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientDeleteCollectionRes.ErrorCode))
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientDeleteCollectionRes.ErrorCode, clientDeleteCollectionRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientDeleteCollectionRes)
+}
+
+// MoveFavHandler handles requests to the /item/move/fav endpoint
+func (i *ItemServiceController) MoveFavHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	var moveFavReq req.MoveFavReq
+	err := c.BindJSON(&moveFavReq)
+	if err != nil {
+		i.logger.Info(
+			constants.ErrorInvalidRequestMsg,
+			zap.Error(err),
+		)
+		errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorInvalidRequest, constants.ErrorInvalidRequestMsg)
+		return
+	}
+	i.logger.Info(
+		constants.InfoItemServiceRequest,
+		zap.Any(constants.Request, moveFavReq),
+	)
+
+	// construct the request to be made as a grpc client to item service
+	clientMoveFavouriteReq := &proto.MoveFavouriteReq{
+		UserID:       userID,
+		ItemID:       moveFavReq.ItemID,
+		ShopID:       moveFavReq.ShopID,
+		CollectionID: moveFavReq.CollectionID,
+	}
+	// call item service
+	clientMoveFavouriteRes, err := i.client.MoveFavourite(c.Request.Context(), clientMoveFavouriteReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientMoveFavouriteRes.ErrorCode))
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientMoveFavouriteRes.ErrorCode, clientMoveFavouriteRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientMoveFavouriteRes)
+}
+
+// AnnotateFavHandler handles requests to the /item/annotate/fav endpoint
+func (i *ItemServiceController) AnnotateFavHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	var annotateFavReq req.AnnotateFavReq
+	err := c.BindJSON(&annotateFavReq)
+	if err != nil {
+		i.logger.Info(
+			constants.ErrorInvalidRequestMsg,
+			zap.Error(err),
+		)
+		errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorInvalidRequest, constants.ErrorInvalidRequestMsg)
+		return
+	}
+	i.logger.Info(
+		constants.InfoItemServiceRequest,
+		zap.Any(constants.Request, annotateFavReq),
+	)
+
+	// construct the request to be made as a grpc client to item service
+	clientAnnotateFavReq := &proto.AnnotateFavReq{
+		UserID: userID,
+		ItemID: annotateFavReq.ItemID,
+		ShopID: annotateFavReq.ShopID,
+		Note:   annotateFavReq.Note,
+		Tags:   annotateFavReq.Tags,
+	}
+	// call item service
+	clientAnnotateFavRes, err := i.client.AnnotateFav(c.Request.Context(), clientAnnotateFavReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientAnnotateFavRes.ErrorCode))
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientAnnotateFavRes.ErrorCode, clientAnnotateFavRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientAnnotateFavRes)
+}
+
+// ListTagsHandler handles requests to the /item/get/tags endpoint
+func (i *ItemServiceController) ListTagsHandler(c *gin.Context) {
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	i.addSpanTags(span, c)
+	defer span.Finish()
+	var errorCodeStr string
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(i.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := i.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	// construct the request to be made as a grpc client to item service
+	clientListTagsReq := &proto.ListTagsReq{
+		UserID: userID,
+	}
+	// call item service
+	clientListTagsRes, err := i.client.ListTags(c.Request.Context(), clientListTagsReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorItemserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorItemserviceConnection, constants.ErrorItemserviceConnectionMsg)
+		return
+	}
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientListTagsRes.ErrorCode))
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientListTagsRes.ErrorCode, clientListTagsRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientListTagsRes)
 }
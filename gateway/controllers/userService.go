@@ -1,13 +1,13 @@
 package controllers
 
 import (
-	client "gateway/client"
+	"context"
+	"fmt"
 	config "gateway/config"
 	constants "gateway/constants"
 	req "gateway/dto/request"
 	res "gateway/dto/response"
 	metrics "gateway/metrics"
-	"gateway/middleware"
 	proto "gateway/proto"
 	"net/http"
 	"strconv"
@@ -15,7 +15,6 @@ import (
 
 	ot "github.com/opentracing/opentracing-go"
 
-	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/gin-gonic/gin"
@@ -25,21 +24,40 @@ import (
 const (
 	loginHandler  = "handler.LoginHandler"
 	signupHandler = "handler.SignupHandler"
+	deleteHandler = "handler.DeleteAccountHandler"
 )
 
+// UserService is the part of the user service client used by the UserServiceController.
+type UserService interface {
+	Signup(ctx context.Context, req *proto.SignupReq) (*proto.SignupRes, error)
+	Login(ctx context.Context, req *proto.LoginReq) (*proto.LoginRes, error)
+	DeleteAccount(ctx context.Context, req *proto.DeleteAccountReq) (*proto.DeleteAccountRes, error)
+	RefreshSession(ctx context.Context, req *proto.RefreshSessionReq) (*proto.RefreshSessionRes, error)
+	RevokeSession(ctx context.Context, req *proto.RevokeSessionReq) (*proto.RevokeSessionRes, error)
+	RevokeAllSessions(ctx context.Context, req *proto.RevokeAllSessionsReq) (*proto.RevokeAllSessionsRes, error)
+}
+
+// FavouritesPurger purges a deleted user's favourites from item service.
+type FavouritesPurger interface {
+	DeleteAllFavourites(ctx context.Context, req *proto.DeleteAllFavouritesReq) (*proto.DeleteAllFavouritesRes, error)
+}
+
 // UserServiceController is called to handle incoming HTTP requests directed to the user service.
+// The item service client is used to purge a deleted user's favourites.
 type UserServiceController struct {
-	config *config.UserServiceConfig
-	logger *zap.Logger
-	client *client.UserServiceClient
+	config     *config.UserServiceConfig
+	logger     *zap.Logger
+	client     UserService
+	itemClient FavouritesPurger
 }
 
 // NewUserServiceController returns a UserServiceController.
-func NewUserServiceController(config *config.UserServiceConfig, logger *zap.Logger, client *client.UserServiceClient) *UserServiceController {
+func NewUserServiceController(config *config.UserServiceConfig, logger *zap.Logger, client UserService, itemClient FavouritesPurger) *UserServiceController {
 	return &UserServiceController{
 		config,
 		logger,
 		client,
+		itemClient,
 	}
 }
 
@@ -87,9 +105,11 @@ func (u *UserServiceController) LoginHandler(c *gin.Context) {
 	)
 
 	// construct the request to be made as a grpc client to user service
+	// the client IP is sent so that user service can limit failed attempts per client IP
 	clientLoginReq := &proto.LoginReq{
 		Username: loginReq.Username,
 		Password: loginReq.Password,
+		ClientIP: c.ClientIP(),
 	}
 
 	// call user service
@@ -101,6 +121,15 @@ func (u *UserServiceController) LoginHandler(c *gin.Context) {
 		SendStandardGatewayResponse(c, span, constants.ErrorUserserviceConnection, constants.ErrorUserserviceConnectionMsg)
 		return
 	}
+	if clientLoginRes.ErrorCode == constants.UserServiceTooManyLoginAttempts {
+		// the username or client IP is delayed or locked after failed attempts
+		errorCodeStr = strconv.Itoa(int(clientLoginRes.ErrorCode))
+		u.removeCookie(c, constants.Token)
+		AddErrorTagsToSpan(span, clientLoginRes.ErrorCode, clientLoginRes.ErrorMsg)
+		c.Header(constants.RetryAfterHeader, strconv.FormatInt(clientLoginRes.RetryAfter, 10))
+		c.IndentedJSON(http.StatusTooManyRequests, res.GatewayResponse{ErrorCode: clientLoginRes.ErrorCode})
+		return
+	}
 	if clientLoginRes.ErrorCode != -1 && clientLoginRes.UserID == 0 {
 		// remove any credentials if there is a login error
 		errorCodeStr = strconv.Itoa(int(clientLoginRes.ErrorCode))
@@ -118,59 +147,223 @@ func (u *UserServiceController) LoginHandler(c *gin.Context) {
 	}
 
 	// a userID was succesfully created by user service
-	// generate the JWT token containing the userID
-	tokenString, expirationTime, err := u.generateToken(clientLoginRes.UserID)
-	if err != nil {
-		// error occured during token generation
-		u.logger.Error(
-			constants.ErrorGenerateJWTTokenMsg,
-			zap.Error(err),
-		)
-		u.removeCookie(c, constants.Token)
-		errorCodeStr = strconv.Itoa(constants.ErrorGenerateJWTToken)
-		// add the resulting error code to the span and send a standard gateway response back to the client
-		SendStandardGatewayResponse(c, span, constants.ErrorGenerateJWTToken, constants.ErrorGenerateJWTTokenMsg)
-		return
+	// set the token issued by user service and the session's refresh token in cookies
+	u.setSessionCookies(c, clientLoginRes.Token, clientLoginRes.TokenExpiresAt, clientLoginRes.RefreshToken, clientLoginRes.RefreshExpiresAt)
+
+	loginRes := res.LoginRes{
+		ErrorCode: clientLoginRes.ErrorCode,
+		ErrorMsg:  clientLoginRes.ErrorMsg,
 	}
 
-	// successful token generation
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(loginRes.ErrorCode))
+	// add resulting errorCode to span
+	AddErrorTagsToSpan(span, loginRes.ErrorCode, loginRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, loginRes)
+}
+
+// setSessionCookies is a helper function to set the JWT token cookie for the user's session, and the cookie of the session's refresh token.
+// The refresh token cookie is only sent to the user service routes.
+func (u *UserServiceController) setSessionCookies(c *gin.Context, token string, tokenExpiresAt int64, refreshToken string, refreshExpiresAt int64) {
 	// set jwt token in cookie
 	http.SetCookie(
 		c.Writer, &http.Cookie{
 			Name:     constants.Token,
-			Value:    tokenString,
-			Expires:  expirationTime,
+			Value:    token,
+			Expires:  time.Unix(tokenExpiresAt, 0),
 			HttpOnly: true,
 			Path:     "/",
 		},
 	)
+	// set refresh token in cookie
+	http.SetCookie(
+		c.Writer, &http.Cookie{
+			Name:     constants.RefreshToken,
+			Value:    refreshToken,
+			Expires:  time.Unix(refreshExpiresAt, 0),
+			HttpOnly: true,
+			Path:     u.config.URLGroup,
+		},
+	)
+}
 
-	loginRes := res.LoginRes{
-		ErrorCode: clientLoginRes.ErrorCode,
-		ErrorMsg:  clientLoginRes.ErrorMsg,
+// RefreshHandler handles requests to the /user/refresh endpoint.
+// The refresh token cookie is exchanged for a new token cookie and refresh token cookie.
+func (u *UserServiceController) RefreshHandler(c *gin.Context) {
+	var errorCodeStr string
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	u.addSpanTags(span, c)
+	defer span.Finish()
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(u.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(u.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	cookie, err := c.Request.Cookie(constants.RefreshToken)
+	if err != nil {
+		u.logger.Info(constants.ErrorNoRefreshTokenMsg, zap.Error(err))
+		errorCodeStr = strconv.Itoa(constants.ErrorNoRefreshToken)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorNoRefreshToken, constants.ErrorNoRefreshTokenMsg)
+		return
+	}
+
+	// call user service
+	clientRefreshRes, err := u.client.RefreshSession(c.Request.Context(), &proto.RefreshSessionReq{RefreshToken: cookie.Value})
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorUserserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorUserserviceConnection, constants.ErrorUserserviceConnectionMsg)
+		return
+	}
+	if clientRefreshRes.ErrorCode != -1 {
+		// the refresh token is invalid, expired or reused, remove any credentials
+		errorCodeStr = strconv.Itoa(int(clientRefreshRes.ErrorCode))
+		u.removeSessionCookies(c)
+		SendStandardGatewayResponse(c, span, clientRefreshRes.ErrorCode, clientRefreshRes.ErrorMsg)
+		return
+	}
+
+	u.setSessionCookies(c, clientRefreshRes.Token, clientRefreshRes.TokenExpiresAt, clientRefreshRes.RefreshToken, clientRefreshRes.RefreshExpiresAt)
+
+	refreshRes := res.RefreshRes{
+		ErrorCode: clientRefreshRes.ErrorCode,
+		ErrorMsg:  clientRefreshRes.ErrorMsg,
 	}
 
 	// convert error code to string for metrics
-	errorCodeStr = strconv.Itoa(int(loginRes.ErrorCode))
+	errorCodeStr = strconv.Itoa(int(refreshRes.ErrorCode))
 	// add resulting errorCode to span
-	AddErrorTagsToSpan(span, loginRes.ErrorCode, loginRes.ErrorMsg)
+	AddErrorTagsToSpan(span, refreshRes.ErrorCode, refreshRes.ErrorMsg)
 	// return response
-	c.IndentedJSON(200, loginRes)
+	c.IndentedJSON(200, refreshRes)
 }
 
-// generateToken is a helper function to generate the JWT token for an authenticated user's session.
-func (u *UserServiceController) generateToken(userID int64) (string, time.Time, error) {
-	expirationTime := time.Now().Add(time.Duration(u.config.Expiry) * time.Minute)
-	claims := &middleware.Claims{
-		UserID: strconv.FormatInt(userID, 10),
-		StandardClaims: jwt.StandardClaims{
-			// In JWT, the expiry time is expressed as unix milliseconds
-			ExpiresAt: expirationTime.Unix(),
-		},
+// LogoutHandler handles requests to the /user/logout endpoint.
+// The session of the request's token is revoked, so that its tokens and refresh token are rejected.
+func (u *UserServiceController) LogoutHandler(c *gin.Context) {
+	var errorCodeStr string
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	u.addSpanTags(span, c)
+	defer span.Finish()
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(u.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(u.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := u.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+	sessionID := c.GetString(constants.SessionID)
+
+	// call user service
+	clientRevokeRes, err := u.client.RevokeSession(c.Request.Context(), &proto.RevokeSessionReq{UserID: userID, SessionID: sessionID})
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorUserserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorUserserviceConnection, constants.ErrorUserserviceConnectionMsg)
+		return
+	}
+	if clientRevokeRes.ErrorCode == -1 {
+		u.logger.Info(
+			constants.InfoLogout,
+			zap.Int64(constants.UserID, userID),
+			zap.String(constants.SessionID, sessionID),
+		)
+		u.removeSessionCookies(c)
+	}
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientRevokeRes.ErrorCode))
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientRevokeRes.ErrorCode, clientRevokeRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientRevokeRes)
+}
+
+// LogoutAllHandler handles requests to the /user/logout-all endpoint.
+// Every session of the user is revoked, including the session of the request's token.
+func (u *UserServiceController) LogoutAllHandler(c *gin.Context) {
+	var errorCodeStr string
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	u.addSpanTags(span, c)
+	defer span.Finish()
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(u.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(u.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := u.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	// call user service
+	clientRevokeAllRes, err := u.client.RevokeAllSessions(c.Request.Context(), &proto.RevokeAllSessionsReq{UserID: userID})
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorUserserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorUserserviceConnection, constants.ErrorUserserviceConnectionMsg)
+		return
+	}
+	if clientRevokeAllRes.ErrorCode == -1 {
+		u.logger.Info(
+			constants.InfoLogoutAll,
+			zap.Int64(constants.UserID, userID),
+			zap.Int64(constants.Count, clientRevokeAllRes.Revoked),
+		)
+		u.removeSessionCookies(c)
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(u.config.Secret))
-	return tokenString, expirationTime, err
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientRevokeAllRes.ErrorCode))
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientRevokeAllRes.ErrorCode, clientRevokeAllRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientRevokeAllRes)
 }
 
 // SignupHandler handles incoming requests to the /user/signup endpoint.
@@ -245,6 +438,175 @@ func (u *UserServiceController) SignupHandler(c *gin.Context) {
 	c.JSON(200, clientLoginRes)
 }
 
+// DeleteAccountHandler handles requests to the /user/delete/account endpoint.
+// The user is deleted by user service once the password is checked, then the user's favourites are purged from item service.
+// Both steps succeed when repeated, so a request that fails after the user is deleted can be retried with the same token.
+func (u *UserServiceController) DeleteAccountHandler(c *gin.Context) {
+	var errorCodeStr string
+	// start tracing span from context
+	span := ot.SpanFromContext(c.Request.Context())
+	u.addSpanTags(span, c)
+	defer span.Finish()
+
+	// observe request latency
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestLatency.WithLabelValues(u.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// observe response size
+	responseSize := prometheus.ObserverFunc(func(v float64) {
+		metrics.ResponseSize.WithLabelValues(u.config.Label, c.Request.URL.Path, errorCodeStr).Observe(v)
+	})
+	defer func() {
+		responseSize.Observe(float64(c.Writer.Size()))
+	}()
+
+	userID := u.getUserID(c, span)
+	if userID == 0 {
+		errorCodeStr = strconv.Itoa(constants.ErrorGetUserIDFromToken)
+		return
+	}
+
+	var deleteAccountReq req.DeleteAccountReq
+	err := c.BindJSON(&deleteAccountReq)
+	if err != nil {
+		u.logger.Info(
+			constants.ErrorBadRequestMsg,
+			zap.Error(err),
+		)
+		errorCodeStr = strconv.Itoa(constants.ErrorInvalidRequest)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorInvalidRequest, constants.ErrorInvalidRequestMsg)
+		return
+	}
+	u.logger.Info(
+		constants.InfoUserServiceRequest,
+		zap.Int64(constants.UserID, userID),
+	)
+
+	// construct the request to be made as a grpc client to user service
+	clientDeleteAccountReq := &proto.DeleteAccountReq{
+		UserID:   userID,
+		Password: deleteAccountReq.Password,
+	}
+
+	// call user service
+	clientDeleteAccountRes, err := u.client.DeleteAccount(c.Request.Context(), clientDeleteAccountReq)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorUserserviceConnection)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorUserserviceConnection, constants.ErrorUserserviceConnectionMsg)
+		return
+	}
+	if clientDeleteAccountRes.ErrorCode != -1 {
+		errorCodeStr = strconv.Itoa(int(clientDeleteAccountRes.ErrorCode))
+		SendStandardGatewayResponse(c, span, clientDeleteAccountRes.ErrorCode, clientDeleteAccountRes.ErrorMsg)
+		return
+	}
+
+	// the user is deleted, purge the user's favourites from item service
+	deleted, err := u.purgeFavourites(c.Request.Context(), userID)
+	if err != nil {
+		errorCodeStr = strconv.Itoa(constants.ErrorPurgeFavourites)
+		// add the resulting error code to the span and send a standard gateway response back to the client
+		SendStandardGatewayResponse(c, span, constants.ErrorPurgeFavourites, constants.ErrorPurgeFavouritesMsg)
+		return
+	}
+	u.logger.Info(
+		constants.InfoAccountDeleted,
+		zap.Int64(constants.UserID, userID),
+		zap.Int64(constants.Deleted, deleted),
+	)
+
+	// the deleted user's sessions are revoked last, so that the token can still be used to retry a failed request
+	clientRevokeAllRes, err := u.client.RevokeAllSessions(c.Request.Context(), &proto.RevokeAllSessionsReq{UserID: userID})
+	if err != nil || clientRevokeAllRes.ErrorCode != -1 {
+		// the sessions expire with the user's remaining tokens
+		u.logger.Error(
+			constants.ErrorRevokeSessionsMsg,
+			zap.Int64(constants.UserID, userID),
+			zap.Error(err),
+		)
+	}
+
+	// remove the deleted user's credentials
+	u.removeSessionCookies(c)
+
+	// convert error code to string for metrics
+	errorCodeStr = strconv.Itoa(int(clientDeleteAccountRes.ErrorCode))
+	// add the resulting error code to the span
+	AddErrorTagsToSpan(span, clientDeleteAccountRes.ErrorCode, clientDeleteAccountRes.ErrorMsg)
+	// return response
+	c.IndentedJSON(200, clientDeleteAccountRes)
+}
+
+// purgeFavourites is a helper function to delete all of the user's favourites from item service.
+// The request is retried with exponential backoff, up to the configured number of attempts.
+// It returns the number of favourites deleted.
+func (u *UserServiceController) purgeFavourites(ctx context.Context, userID int64) (int64, error) {
+	attempts := u.config.PurgeAttempts
+	if attempts <= 0 {
+		attempts = 1
+	}
+	backoff := time.Duration(u.config.PurgeBackoff) * time.Millisecond
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		var clientRes *proto.DeleteAllFavouritesRes
+		clientRes, err = u.itemClient.DeleteAllFavourites(ctx, &proto.DeleteAllFavouritesReq{UserID: userID})
+		if err == nil && clientRes.ErrorCode != -1 {
+			err = fmt.Errorf("%s: %d", constants.ErrorPurgeFavouritesMsg, clientRes.ErrorCode)
+		}
+		if err == nil {
+			return clientRes.Deleted, nil
+		}
+		u.logger.Error(
+			constants.ErrorPurgeFavouritesMsg,
+			zap.Int64(constants.UserID, userID),
+			zap.Int(constants.Attempt, attempt),
+			zap.Error(err),
+		)
+		if attempt >= attempts {
+			return 0, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// getUserID is a helper function to retrieve the userID set in the context by the authentication middleware.
+// It sends an error response to the client and returns 0 if the userID cannot be retrieved.
+func (u *UserServiceController) getUserID(c *gin.Context, span ot.Span) int64 {
+	userIDRaw, exists := c.Get(constants.UserID)
+	if !exists {
+		u.logger.Error(constants.ErrorNoUserIDInTokenMsg)
+		SendStandardGatewayResponse(c, span, constants.ErrorNoUserIDInToken, constants.ErrorNoUserIDInTokenMsg)
+		return 0
+	}
+
+	userID, err := strconv.ParseInt(userIDRaw.(string), 10, 64)
+	if err != nil {
+		u.logger.Error(constants.ErrorParseIntMsg, zap.Error(err))
+		SendStandardGatewayResponse(c, span, constants.ErrorGetUserIDFromToken, constants.ErrorGetUserIDFromTokenMsg)
+		return 0
+	}
+	return userID
+}
+
+// removeSessionCookies is a helper function to remove the token and refresh token cookies from the client side.
+func (u *UserServiceController) removeSessionCookies(c *gin.Context) {
+	http.SetCookie(c.Writer, &http.Cookie{Name: constants.Token, MaxAge: -1, Path: "/"})
+	http.SetCookie(c.Writer, &http.Cookie{Name: constants.RefreshToken, MaxAge: -1, Path: u.config.URLGroup})
+}
+
 // removeCookie is a helper function to remove the http cookie with cookieName from the client side.
 func (u *UserServiceController) removeCookie(c *gin.Context, cookieName string) {
 	// set jwt token in cookie
@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	config "gateway/config"
+	constants "gateway/constants"
+	res "gateway/dto/response"
+	metrics "gateway/metrics"
+	"gateway/middleware"
+	proto "gateway/proto"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	metrics.Init()
+	os.Exit(m.Run())
+}
+
+// fakeUserService stands in for user service with a single user, 1, logged in with a single session.
+// Like user service, deleting the account does not revoke the user's sessions, and deleting a deleted user succeeds.
+// The methods the tests do not use are left to the embedded nil UserService.
+type fakeUserService struct {
+	UserService
+	mu      sync.Mutex
+	deleted bool
+	revoked bool
+}
+
+func (u *fakeUserService) DeleteAccount(ctx context.Context, req *proto.DeleteAccountReq) (*proto.DeleteAccountRes, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.deleted = true
+	return &proto.DeleteAccountRes{ErrorCode: -1}, nil
+}
+
+func (u *fakeUserService) RevokeAllSessions(ctx context.Context, req *proto.RevokeAllSessionsReq) (*proto.RevokeAllSessionsRes, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.revoked = true
+	return &proto.RevokeAllSessionsRes{ErrorCode: -1}, nil
+}
+
+func (u *fakeUserService) CheckSession(ctx context.Context, req *proto.CheckSessionReq) (*proto.CheckSessionRes, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return &proto.CheckSessionRes{ErrorCode: -1, UserID: 1, Active: !u.revoked}, nil
+}
+
+// ValidateToken accepts any token as the session's token until the session is revoked.
+func (u *fakeUserService) ValidateToken(ctx context.Context, req *proto.ValidateTokenReq) (*proto.ValidateTokenRes, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.revoked {
+		return &proto.ValidateTokenRes{ErrorCode: constants.UserServiceSessionRevoked}, nil
+	}
+	return &proto.ValidateTokenRes{ErrorCode: -1, Active: true, UserID: 1, SessionID: "session", ExpiresAt: time.Now().Add(time.Hour).Unix()}, nil
+}
+
+// fakeItemService purges the favourites of user 1, after failing the given number of purges.
+type fakeItemService struct {
+	mu         sync.Mutex
+	failures   int
+	calls      int
+	favourites int64
+}
+
+func (i *fakeItemService) DeleteAllFavourites(ctx context.Context, req *proto.DeleteAllFavouritesReq) (*proto.DeleteAllFavouritesRes, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.calls++
+	if i.calls <= i.failures {
+		return nil, errors.New("item service unavailable")
+	}
+	deleted := i.favourites
+	i.favourites = 0
+	return &proto.DeleteAllFavouritesRes{ErrorCode: -1, Deleted: deleted}, nil
+}
+
+// deleteAccountRouter returns a router that authenticates delete account requests with user service, and handles them with the controller.
+func deleteAccountRouter(cfg *config.UserServiceConfig, users *fakeUserService, items *fakeItemService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	controller := NewUserServiceController(cfg, zap.NewNop(), users, items)
+	authenticate := middleware.Authenticate(&config.AuthConfig{Mode: config.AuthModeIntrospect, CacheSize: 10}, nil, users, zap.NewNop())
+
+	router := gin.New()
+	router.DELETE("/delete/account", authenticate, controller.DeleteAccountHandler)
+	return router
+}
+
+// deleteAccount sends a delete account request with the token, and returns the response status and error code.
+func deleteAccount(t *testing.T, router *gin.Engine, token string) (int, int32) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodDelete, "/delete/account", strings.NewReader(`{"password": "password"}`))
+	req.AddCookie(&http.Cookie{Name: constants.Token, Value: token})
+	// the tracing middleware starts a span for each request
+	req = req.WithContext(ot.ContextWithSpan(req.Context(), ot.NoopTracer{}.StartSpan(deleteHandler)))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var body res.GatewayResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("response body %q: %v", w.Body.String(), err)
+	}
+	return w.Code, body.ErrorCode
+}
+
+func TestDeleteAccountCanBeRetriedWithTheSameTokenAfterAFailedPurge(t *testing.T) {
+	users := &fakeUserService{}
+	items := &fakeItemService{failures: 1, favourites: 3}
+	router := deleteAccountRouter(&config.UserServiceConfig{Label: "userservice", PurgeAttempts: 1}, users, items)
+
+	// the user is deleted, but the favourites are not purged, so the session is kept for a retry
+	if status, code := deleteAccount(t, router, "token"); status != http.StatusOK || code != constants.ErrorPurgeFavourites {
+		t.Fatalf("first request: got status %d and error code %d, want %d and ErrorPurgeFavourites", status, code, http.StatusOK)
+	}
+	if !users.deleted || users.revoked || items.favourites != 3 {
+		t.Fatalf("after the failed purge: got deleted %v, revoked %v, %d favourites", users.deleted, users.revoked, items.favourites)
+	}
+
+	// the retry with the same token finishes the deletion
+	if status, code := deleteAccount(t, router, "token"); status != http.StatusOK || code != -1 {
+		t.Fatalf("retry: got status %d and error code %d, want %d and -1", status, code, http.StatusOK)
+	}
+	if items.favourites != 0 || !users.revoked {
+		t.Errorf("after the retry: got %d favourites, revoked %v, want the favourites purged and the sessions revoked", items.favourites, users.revoked)
+	}
+
+	// the deleted user's token is no longer accepted
+	if status, _ := deleteAccount(t, router, "token"); status != http.StatusUnauthorized {
+		t.Errorf("after the deletion: got status %d, want %d", status, http.StatusUnauthorized)
+	}
+}
+
+func TestDeleteAccountRetriesTheFailedPurge(t *testing.T) {
+	users := &fakeUserService{}
+	items := &fakeItemService{failures: 2, favourites: 3}
+	router := deleteAccountRouter(&config.UserServiceConfig{Label: "userservice", PurgeAttempts: 3, PurgeBackoff: 1}, users, items)
+
+	// the purge is retried within the request, so the deletion finishes without a retry by the client
+	if status, code := deleteAccount(t, router, "token"); status != http.StatusOK || code != -1 {
+		t.Fatalf("request: got status %d and error code %d, want %d and -1", status, code, http.StatusOK)
+	}
+	if items.calls != 3 || items.favourites != 0 || !users.deleted || !users.revoked {
+		t.Errorf("after the request: got %d purges, %d favourites, deleted %v, revoked %v", items.calls, items.favourites, users.deleted, users.revoked)
+	}
+}
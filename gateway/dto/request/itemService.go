@@ -4,6 +4,30 @@ package request
 type AddFavReq struct {
 	ItemID string `json:"itemID"`
 	ShopID string `json:"shopID"`
+	// TargetPrice and DropPercent optionally set a price-drop alert on the favourite
+	TargetPrice int64 `json:"targetPrice"`
+	DropPercent int32 `json:"dropPercent"`
+}
+
+// UpdateFavReq defines the expected request body to UpdateFav
+type UpdateFavReq struct {
+	ItemID      int64 `json:"itemID"`
+	ShopID      int64 `json:"shopID"`
+	TargetPrice int64 `json:"targetPrice"`
+	DropPercent int32 `json:"dropPercent"`
+}
+
+// BatchFavReq defines the expected request body to BatchAddFav and BatchDeleteFav
+type BatchFavReq struct {
+	Items []BatchFavItem `json:"items"`
+}
+
+// BatchFavItem defines an item in BatchFavReq. TargetPrice and DropPercent are ignored by BatchDeleteFav
+type BatchFavItem struct {
+	ItemID      int64 `json:"itemID"`
+	ShopID      int64 `json:"shopID"`
+	TargetPrice int64 `json:"targetPrice"`
+	DropPercent int32 `json:"dropPercent"`
 }
 
 // DeleteFavReq defines the expected request body to DeleteFav
@@ -11,3 +35,29 @@ type DeleteFavReq struct {
 	ItemID int64 `json:"itemID"`
 	ShopID int64 `json:"shopID"`
 }
+
+// CreateCollectionReq defines the expected request body to CreateCollection
+type CreateCollectionReq struct {
+	Name string `json:"name"`
+}
+
+// RenameCollectionReq defines the expected request body to RenameCollection
+type RenameCollectionReq struct {
+	CollectionID int64  `json:"collectionID"`
+	Name         string `json:"name"`
+}
+
+// MoveFavReq defines the expected request body to MoveFav
+type MoveFavReq struct {
+	ItemID       int64 `json:"itemID"`
+	ShopID       int64 `json:"shopID"`
+	CollectionID int64 `json:"collectionID"`
+}
+
+// AnnotateFavReq defines the expected request body to AnnotateFav
+type AnnotateFavReq struct {
+	ItemID int64    `json:"itemID"`
+	ShopID int64    `json:"shopID"`
+	Note   string   `json:"note"`
+	Tags   []string `json:"tags"`
+}
@@ -6,6 +6,11 @@ type LoginReq struct {
 	Password string `json:"password"`
 }
 
+// DeleteAccountReq defines the expected incoming request body to DeleteAccount
+type DeleteAccountReq struct {
+	Password string `json:"password"`
+}
+
 // SignupReq defines the expected incoming request body to Signup
 type SignupReq struct {
 	Username string `json:"username"`
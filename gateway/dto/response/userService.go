@@ -5,3 +5,9 @@ type LoginRes struct {
 	ErrorCode int32  `json:"errorCode"`
 	ErrorMsg  string `json:"errorMsg"`
 }
+
+// RefreshRes defines the response sent back to the client by the gateway. It removes the userID and session from the initial response received from the user service.
+type RefreshRes struct {
+	ErrorCode int32  `json:"errorCode"`
+	ErrorMsg  string `json:"errorMsg"`
+}
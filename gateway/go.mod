@@ -3,8 +3,8 @@ module gateway
 go 1.18
 
 require (
-	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/gin-gonic/gin v1.8.1
+	github.com/golang-jwt/jwt/v4 v4.4.2
 	github.com/prometheus/client_golang v1.13.0
 	github.com/spf13/viper v1.12.0
 	go.uber.org/zap v1.22.0
@@ -0,0 +1,203 @@
+// Package keyring holds the public keys that verify JWT tokens issued by user service.
+// Each key is identified by its kid, so that several keys can verify tokens while user service rotates its signing key.
+package keyring
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	constants "gateway/constants"
+	"math/big"
+	"sync"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	// AlgorithmRS256 verifies tokens with an RSA key
+	AlgorithmRS256 = "RS256"
+	// AlgorithmEdDSA verifies tokens with an Ed25519 key
+	AlgorithmEdDSA = "EdDSA"
+
+	// headerKeyID is the token header holding the ID of the key that signed the token
+	headerKeyID = "kid"
+)
+
+var (
+	// ErrUnknownKey is returned when a token was signed by a key that is not in the key ring
+	ErrUnknownKey = errors.New("keyring: unknown key")
+	// ErrAlgorithmMismatch is returned when a token's algorithm is not the algorithm of the key that signed it
+	ErrAlgorithmMismatch = errors.New("keyring: algorithm does not match key")
+)
+
+// JWK is a public key in the JSON Web Key format, as defined in RFC 7517.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	// N and E are the modulus and exponent of an RSA key
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Curve and X are the curve and public key of an Ed25519 key
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JWKS is a set of public keys in the JSON Web Key Set format, as defined in RFC 7517.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// key is a public key in the key ring.
+type key struct {
+	algorithm string
+	publicKey crypto.PublicKey
+}
+
+// KeyRing verifies tokens signed by any of its keys. It is safe for concurrent use, and its keys are replaced by Refresh.
+type KeyRing struct {
+	fetch  func(ctx context.Context) (JWKS, error)
+	logger *zap.Logger
+
+	mu      sync.RWMutex
+	keys    map[string]key
+	methods []string
+	jwks    JWKS
+}
+
+// New returns an empty key ring that gets its keys from fetch. Tokens are rejected until the keys are refreshed.
+func New(fetch func(ctx context.Context) (JWKS, error), logger *zap.Logger) *KeyRing {
+	return &KeyRing{
+		fetch:  fetch,
+		logger: logger,
+		keys:   make(map[string]key),
+		jwks:   JWKS{Keys: []JWK{}},
+	}
+}
+
+// Refresh fetches the keys and replaces the keys of the key ring. The keys are kept if any key fails to decode.
+func (r *KeyRing) Refresh(ctx context.Context) error {
+	jwks, err := r.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]key, len(jwks.Keys))
+	var methods []string
+	for _, jwk := range jwks.Keys {
+		publicKey, err := decodeJWK(jwk)
+		if err != nil {
+			return fmt.Errorf("keyring: key %q: %w", jwk.KeyID, err)
+		}
+		keys[jwk.KeyID] = key{algorithm: jwk.Algorithm, publicKey: publicKey}
+		if !contains(methods, jwk.Algorithm) {
+			methods = append(methods, jwk.Algorithm)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keys = keys
+	r.methods = methods
+	r.jwks = jwks
+	return nil
+}
+
+// Run refreshes the keys now and then at every interval, until the context is done.
+// Refresh errors are logged, and the previous keys are kept until the next refresh.
+func (r *KeyRing) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Refresh(ctx); err != nil {
+			r.logger.Error(constants.ErrorRefreshKeysMsg, zap.Error(err))
+		} else {
+			r.logger.Info(constants.InfoKeysRefreshed, zap.Int(constants.Count, len(r.JWKS().Keys)))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Keyfunc returns the public key that verifies the token, found by the token's kid header.
+// The token must use the key's algorithm, so that a token cannot be verified with a key of another algorithm.
+func (r *KeyRing) Keyfunc(token *jwt.Token) (interface{}, error) {
+	id, _ := token.Header[headerKeyID].(string)
+
+	r.mu.RLock()
+	k, ok := r.keys[id]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	if token.Method == nil || token.Method.Alg() != k.algorithm {
+		return nil, ErrAlgorithmMismatch
+	}
+	return k.publicKey, nil
+}
+
+// Methods returns the algorithms of the keys in the key ring, used to reject tokens with any other algorithm when parsing.
+func (r *KeyRing) Methods() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.methods
+}
+
+// JWKS returns the public keys of the key ring.
+func (r *KeyRing) JWKS() JWKS {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.jwks
+}
+
+// decodeJWK returns the public key of the JWK.
+func decodeJWK(jwk JWK) (crypto.PublicKey, error) {
+	switch jwk.Algorithm {
+	case AlgorithmRS256:
+		if jwk.KeyType != "RSA" {
+			return nil, fmt.Errorf("key type %q is not RSA", jwk.KeyType)
+		}
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case AlgorithmEdDSA:
+		if jwk.KeyType != "OKP" || jwk.Curve != "Ed25519" {
+			return nil, fmt.Errorf("key type %q with curve %q is not Ed25519", jwk.KeyType, jwk.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 public key size")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("unsupported algorithm %q", jwk.Algorithm)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
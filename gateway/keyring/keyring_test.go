@@ -0,0 +1,133 @@
+package keyring
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+// testKeys returns the published keys of an Ed25519 key and an older RSA key, and their private keys.
+func testKeys(t *testing.T) (JWKS, ed25519.PrivateKey, *rsa.PrivateKey) {
+	t.Helper()
+	edPublic, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	jwks := JWKS{Keys: []JWK{
+		{
+			KeyType:   "RSA",
+			KeyID:     "old",
+			Algorithm: AlgorithmRS256,
+			Use:       "sig",
+			N:         base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+			E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+		},
+		{
+			KeyType:   "OKP",
+			KeyID:     "new",
+			Algorithm: AlgorithmEdDSA,
+			Use:       "sig",
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(edPublic),
+		},
+	}}
+	return jwks, edKey, rsaKey
+}
+
+// sign returns a token signed by key with the method, and the kid header set to id.
+func sign(t *testing.T, method jwt.SigningMethod, id string, key interface{}) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))})
+	token.Header[headerKeyID] = id
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+	return signed
+}
+
+// verify parses the token with the key ring.
+func verify(keys *KeyRing, token string) error {
+	_, err := jwt.Parse(token, keys.Keyfunc, jwt.WithValidMethods(keys.Methods()))
+	return err
+}
+
+func TestKeyRingVerify(t *testing.T) {
+	jwks, edKey, rsaKey := testKeys(t)
+	keys := New(func(ctx context.Context) (JWKS, error) { return jwks, nil }, zap.NewNop())
+
+	token := sign(t, jwt.SigningMethodEdDSA, "new", edKey)
+	if err := verify(keys, token); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("verify before refresh: got %v, want %v", err, ErrUnknownKey)
+	}
+
+	if err := keys.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if err := verify(keys, token); err != nil {
+		t.Fatalf("verify EdDSA token: %v", err)
+	}
+	if err := verify(keys, sign(t, jwt.SigningMethodRS256, "old", rsaKey)); err != nil {
+		t.Fatalf("verify RS256 token: %v", err)
+	}
+	if got := len(keys.JWKS().Keys); got != 2 {
+		t.Fatalf("JWKS: got %d keys, want 2", got)
+	}
+}
+
+func TestKeyRingRejectsUnknownKeyAndAlgorithm(t *testing.T) {
+	jwks, edKey, rsaKey := testKeys(t)
+	keys := New(func(ctx context.Context) (JWKS, error) { return jwks, nil }, zap.NewNop())
+	if err := keys.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+
+	if err := verify(keys, sign(t, jwt.SigningMethodEdDSA, "missing", edKey)); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("unknown kid: got %v, want %v", err, ErrUnknownKey)
+	}
+	// a token for the RSA key's kid, signed with another algorithm
+	if err := verify(keys, sign(t, jwt.SigningMethodEdDSA, "old", edKey)); !errors.Is(err, ErrAlgorithmMismatch) {
+		t.Fatalf("algorithm mismatch: got %v, want %v", err, ErrAlgorithmMismatch)
+	}
+	// a token signed with HMAC, using the published public key as the secret
+	if err := verify(keys, sign(t, jwt.SigningMethodHS256, "old", rsaKey.N.Bytes())); err == nil {
+		t.Fatal("HS256 token: got nil error")
+	}
+}
+
+func TestKeyRingKeepsKeysWhenRefreshFails(t *testing.T) {
+	jwks, edKey, _ := testKeys(t)
+	fetchErr := errors.New("unavailable")
+	fail := false
+	keys := New(func(ctx context.Context) (JWKS, error) {
+		if fail {
+			return JWKS{}, fetchErr
+		}
+		return jwks, nil
+	}, zap.NewNop())
+	if err := keys.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+
+	fail = true
+	if err := keys.Refresh(context.Background()); !errors.Is(err, fetchErr) {
+		t.Fatalf("Refresh: got %v, want %v", err, fetchErr)
+	}
+	if err := verify(keys, sign(t, jwt.SigningMethodEdDSA, "new", edKey)); err != nil {
+		t.Fatalf("verify after failed refresh: %v", err)
+	}
+}
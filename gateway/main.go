@@ -1,14 +1,17 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	client "gateway/client"
 	constants "gateway/constants"
 	controllers "gateway/controllers"
+	keyring "gateway/keyring"
 	metrics "gateway/metrics"
 	middleware "gateway/middleware"
 	routes "gateway/routes"
 	jaegerTracer "gateway/tracing"
+	"time"
 
 	otgrpc "github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 
@@ -65,8 +68,19 @@ func StartHTTPServer(logger *zap.Logger, config *config.Config, clients *GrpcCli
 		gin.SetMode(gin.ReleaseMode)
 	}
 
+	// fetch the public keys that verify tokens from user service, and refresh them while the gateway runs
+	keys := keyring.New(clients.UserServiceClient.FetchJWKS, logger)
+	go keys.Run(context.Background(), time.Duration(config.AuthConfig.KeyRefresh)*time.Second)
+
 	server := gin.New()
-	server.SetTrustedProxies([]string{"*"})
+	// only the reverse proxy may set the client IP in X-Forwarded-For, as login attempts are limited per client IP
+	if err := server.SetTrustedProxies(config.TrustedProxies); err != nil {
+		logger.Fatal(
+			constants.ErrorTrustedProxiesMsg,
+			zap.Strings("trustedProxies", config.TrustedProxies),
+			zap.Error(err),
+		)
+	}
 
 	// ignore metrics endpoint when logging
 	server.Use(gin.LoggerWithConfig(gin.LoggerConfig{SkipPaths: []string{config.PrometheusConfig.Endpoint}}))
@@ -77,20 +91,25 @@ func StartHTTPServer(logger *zap.Logger, config *config.Config, clients *GrpcCli
 
 	// prometheus metrics endpoint
 	server.GET(config.PrometheusConfig.Endpoint, metrics.PrometheusHandler())
+	// public keys to verify tokens
+	server.GET(config.AuthConfig.JWKSEndpoint, controllers.JWKSHandler(keys))
+
+	// authenticates requests with the token cookie, and rejects tokens of revoked sessions
+	authenticate := middleware.Authenticate(&config.AuthConfig, keys, clients.UserServiceClient, logger)
 
 	// Routes for User Service
 	userServiceGroup := server.Group(config.HTTPConfig.UserService.URLGroup)
-	userServiceController := controllers.NewUserServiceController(&config.HTTPConfig.UserService, logger, clients.UserServiceClient)
+	userServiceController := controllers.NewUserServiceController(&config.HTTPConfig.UserService, logger, clients.UserServiceClient, clients.ItemServiceClient)
 	userServiceGroup.Use(middleware.PrometheusMiddleware(config)) // use prometheus middleware
 	userServiceGroup.Use(ginhttp.Middleware(tracer))              // use ginhttp middleware for tracing
-	routes.UserServiceRoutes(userServiceGroup, userServiceController, &config.HTTPConfig.UserService.APIs)
+	routes.UserServiceRoutes(userServiceGroup, userServiceController, &config.HTTPConfig.UserService.APIs, authenticate)
 
 	// Routes for Item Service
 	itemServiceGroup := server.Group(config.HTTPConfig.ItemService.URLGroup)
 	itemServiceController := controllers.NewItemServiceController(&config.HTTPConfig.ItemService, logger, clients.ItemServiceClient)
-	itemServiceGroup.Use(middleware.Authenticate(config.HTTPConfig.UserService.Secret, logger)) // authenticate requests to item service
-	itemServiceGroup.Use(middleware.PrometheusMiddleware(config))                               // use prometheus middleware
-	itemServiceGroup.Use(ginhttp.Middleware(tracer))                                            // use ginhttp middleware for tracing
+	itemServiceGroup.Use(authenticate)                            // authenticate requests to item service
+	itemServiceGroup.Use(middleware.PrometheusMiddleware(config)) // use prometheus middleware
+	itemServiceGroup.Use(ginhttp.Middleware(tracer))              // use ginhttp middleware for tracing
 	routes.ItemServiceRoutes(itemServiceGroup, itemServiceController, &config.HTTPConfig.ItemService.APIs)
 
 	err := server.Run(fmt.Sprintf(":%s", config.Port))
@@ -113,7 +132,7 @@ func StartGrpcClients(logger *zap.Logger, config *config.Config, tracer ot.Trace
 	generalOpts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithUnaryInterceptor(otgrpc.OpenTracingClientInterceptor(tracer)),
-		// grpc.WithStreamInterceptor(otgrpc.OpenTracingStreamClientInterceptor(tracer)),
+		grpc.WithStreamInterceptor(otgrpc.OpenTracingStreamClientInterceptor(tracer)),
 	}
 	// user service client
 	userServiceClient := client.GetUserServiceClient(logger, &config.GrpcConfig.UserService)
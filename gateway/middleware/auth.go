@@ -1,29 +1,50 @@
 package middleware
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	config "gateway/config"
 	constants "gateway/constants"
 	res "gateway/dto/response"
+	"gateway/keyring"
 	metrics "gateway/metrics"
+	proto "gateway/proto"
 	"net/http"
 	"strconv"
+	"time"
 
-	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
+	jwt "github.com/golang-jwt/jwt/v4"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
 
 // Claims is a struct that will be encoded to a JWT.
 // jwt.StandardClaims is added as an embedded type, to provide fields like expiry time.
+// SessionID is the session the token was issued for, and the token's ID is set in jwt.StandardClaims.
 type Claims struct {
-	UserID string `json:"userID"`
+	UserID    string `json:"userID"`
+	SessionID string `json:"sid"`
 	jwt.StandardClaims
 }
 
-// Authenticate middleware is called on relevant routes to retrieve the token cookie attached with the request and validate it using the jwt key.
-// It
-func Authenticate(secret string, logger *zap.Logger) gin.HandlerFunc {
+// TokenValidator checks the sessions that tokens were issued for, and validates tokens, with user service.
+type TokenValidator interface {
+	CheckSession(ctx context.Context, req *proto.CheckSessionReq) (*proto.CheckSessionRes, error)
+	ValidateToken(ctx context.Context, req *proto.ValidateTokenReq) (*proto.ValidateTokenRes, error)
+}
+
+// Authenticate middleware is called on relevant routes to retrieve the token cookie attached with the request and validate it.
+// In local mode, the token is verified with the key ring, and only tokens signed by a key in the key ring, with the key's algorithm, are accepted.
+// The token's session is checked with user service, and the session's status is cached for a short time.
+// In introspect mode, the token is validated by user service, and the result is cached for a short time.
+// Either way, it rejects tokens of sessions that were revoked by logging out, or that have expired.
+func Authenticate(cfg *config.AuthConfig, keys *keyring.KeyRing, users TokenValidator, logger *zap.Logger) gin.HandlerFunc {
+	cache := newResultCache(cfg.CacheSize)
+	cacheTTL := time.Duration(cfg.CacheTTL) * time.Second
+
 	return func(c *gin.Context) {
 		validationSuccess := false // label used for metrics
 		// observe latency
@@ -48,38 +69,139 @@ func Authenticate(secret string, logger *zap.Logger) gin.HandlerFunc {
 			c.Abort()
 			return
 		}
-		tokenString := cookie.Value
-		claims := &Claims{}
 
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-			return []byte(secret), nil
-		})
-
-		if err != nil {
-			if err == jwt.ErrSignatureInvalid {
-				logger.Error(constants.ErrorJWTSignatureInvalidMsg, zap.Error(err))
-				c.IndentedJSON(http.StatusUnauthorized, constants.Unauthorized)
-				c.Abort()
-				return
-			}
-			logger.Error(constants.ErrorUnexpectedJWTErr, zap.Error(err))
-			c.IndentedJSON(http.StatusBadRequest, constants.BadRequest)
+		var claims *Claims
+		if cfg.Mode == config.AuthModeIntrospect {
+			claims = introspectToken(c, cookie.Value, users, cache, cacheTTL, logger)
+		} else {
+			claims = verifyToken(c, cookie.Value, keys, users, cache, cacheTTL, logger)
+		}
+		if claims == nil {
+			// the response was sent by the mode's validation
 			c.Abort()
 			return
 		}
 
 		validationSuccess = true
+		c.Set(constants.UserID, claims.UserID)
+		c.Set(constants.SessionID, claims.SessionID)
+		c.Next()
+	}
+}
+
+// verifyToken is a helper function that verifies the token with the key ring, and checks that its session is still active with user service.
+// Active and revoked sessions are cached for at most cacheTTL, so a session revoked by logging out is accepted for at most cacheTTL.
+// It returns the token's claims, or sends the error response and returns nil if the token is rejected.
+func verifyToken(c *gin.Context, tokenString string, keys *keyring.KeyRing, sessions TokenValidator, cache *resultCache, cacheTTL time.Duration, logger *zap.Logger) *Claims {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, keys.Keyfunc, jwt.WithValidMethods(keys.Methods()))
 
-		if !token.Valid {
-			logger.Info(constants.InfoInvalidTokenReceived, zap.String(constants.Token, tokenString))
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) || errors.Is(err, jwt.ErrTokenNotValidYet) {
+			logger.Info(constants.InfoInvalidTokenReceived, zap.Error(err))
 			c.IndentedJSON(http.StatusUnauthorized, constants.Unauthorized)
-			c.Abort()
-			return
+			return nil
 		}
+		if errors.Is(err, jwt.ErrSignatureInvalid) || errors.Is(err, jwt.ErrTokenSignatureInvalid) ||
+			errors.Is(err, keyring.ErrUnknownKey) || errors.Is(err, keyring.ErrAlgorithmMismatch) {
+			logger.Error(constants.ErrorJWTSignatureInvalidMsg, zap.Error(err))
+			c.IndentedJSON(http.StatusUnauthorized, constants.Unauthorized)
+			return nil
+		}
+		logger.Error(constants.ErrorUnexpectedJWTErr, zap.Error(err))
+		c.IndentedJSON(http.StatusBadRequest, constants.BadRequest)
+		return nil
+	}
 
-		c.Set(constants.UserID, claims.UserID)
-		c.Next()
+	if !token.Valid || claims.SessionID == "" {
+		logger.Info(constants.InfoInvalidTokenReceived, zap.String(constants.Token, tokenString))
+		c.IndentedJSON(http.StatusUnauthorized, constants.Unauthorized)
+		return nil
+	}
+
+	// the session must still be active
+	result, ok := cache.get(claims.SessionID)
+	if !ok {
+		checkSessionRes, err := sessions.CheckSession(c.Request.Context(), &proto.CheckSessionReq{SessionID: claims.SessionID})
+		if err != nil || checkSessionRes.ErrorCode != -1 {
+			logger.Error(constants.ErrorUserserviceConnectionMsg, zap.Error(err))
+			c.IndentedJSON(http.StatusInternalServerError, res.GatewayResponse{ErrorCode: constants.ErrorUserserviceConnection})
+			return nil
+		}
+		result = cachedResult{errorCode: constants.ErrorSessionRevoked}
+		if checkSessionRes.Active {
+			result = cachedResult{
+				claims: &Claims{
+					UserID:    strconv.FormatInt(checkSessionRes.UserID, 10),
+					SessionID: claims.SessionID,
+				},
+			}
+		}
+		result.expiresAt = now().Add(cacheTTL)
+		cache.set(claims.SessionID, result)
+	}
+
+	if result.claims == nil || result.claims.UserID != claims.UserID {
+		logger.Info(
+			constants.ErrorSessionRevokedMsg,
+			zap.String(constants.UserID, claims.UserID),
+			zap.String(constants.SessionID, claims.SessionID),
+		)
+		c.IndentedJSON(http.StatusUnauthorized, res.GatewayResponse{ErrorCode: constants.ErrorSessionRevoked})
+		return nil
+	}
+	return claims
+}
+
+// introspectToken is a helper function that validates the token with user service, using the cached result if there is one.
+// Tokens that are active, and tokens that user service rejected as invalid or revoked, are cached for at most cacheTTL,
+// and active tokens are not cached past their expiry. A session revoked by logging out is therefore accepted for at most cacheTTL.
+// It returns the token's claims, or sends the error response and returns nil if the token is rejected.
+func introspectToken(c *gin.Context, tokenString string, users TokenValidator, cache *resultCache, cacheTTL time.Duration, logger *zap.Logger) *Claims {
+	hash := sha256.Sum256([]byte(tokenString))
+	key := hex.EncodeToString(hash[:])
+
+	result, ok := cache.get(key)
+	if !ok {
+		validateRes, err := users.ValidateToken(c.Request.Context(), &proto.ValidateTokenReq{Token: tokenString})
+		if err != nil {
+			logger.Error(constants.ErrorIntrospectTokenMsg, zap.Error(err))
+			c.IndentedJSON(http.StatusInternalServerError, res.GatewayResponse{ErrorCode: constants.ErrorUserserviceConnection})
+			return nil
+		}
+
+		expiresAt := now().Add(cacheTTL)
+		switch validateRes.ErrorCode {
+		case -1:
+			result = cachedResult{
+				claims: &Claims{
+					UserID:    strconv.FormatInt(validateRes.UserID, 10),
+					SessionID: validateRes.SessionID,
+				},
+			}
+			if tokenExpiry := time.Unix(validateRes.ExpiresAt, 0); tokenExpiry.Before(expiresAt) {
+				expiresAt = tokenExpiry
+			}
+		case constants.UserServiceTokenInvalid:
+			result = cachedResult{errorCode: constants.ErrorTokenInvalid}
+		case constants.UserServiceSessionRevoked:
+			result = cachedResult{errorCode: constants.ErrorSessionRevoked}
+		default:
+			// the token could not be validated, e.g. a database error, so the result is not cached
+			logger.Error(constants.ErrorIntrospectTokenMsg, zap.Int32(constants.ErrorCode, validateRes.ErrorCode))
+			c.IndentedJSON(http.StatusInternalServerError, res.GatewayResponse{ErrorCode: constants.ErrorUserserviceConnection})
+			return nil
+		}
+		result.expiresAt = expiresAt
+		cache.set(key, result)
+	}
+
+	if result.claims == nil {
+		logger.Info(constants.InfoInvalidTokenReceived, zap.Int32(constants.ErrorCode, result.errorCode))
+		c.IndentedJSON(http.StatusUnauthorized, res.GatewayResponse{ErrorCode: result.errorCode})
+		return nil
 	}
+	return result.claims
 }
 
 // CORSMiddleware enables cross origin resource sharing.
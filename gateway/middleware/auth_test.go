@@ -0,0 +1,185 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	config "gateway/config"
+	constants "gateway/constants"
+	"gateway/keyring"
+	metrics "gateway/metrics"
+	proto "gateway/proto"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	metrics.Init()
+	os.Exit(m.Run())
+}
+
+// fakeValidator answers session checks and token validations with fixed responses, and counts the calls.
+type fakeValidator struct {
+	checkSessionRes  *proto.CheckSessionRes
+	validateTokenRes *proto.ValidateTokenRes
+	checks           int
+	validations      int
+}
+
+func (v *fakeValidator) CheckSession(ctx context.Context, req *proto.CheckSessionReq) (*proto.CheckSessionRes, error) {
+	v.checks++
+	return v.checkSessionRes, nil
+}
+
+func (v *fakeValidator) ValidateToken(ctx context.Context, req *proto.ValidateTokenReq) (*proto.ValidateTokenRes, error) {
+	v.validations++
+	return v.validateTokenRes, nil
+}
+
+// testKeyRing returns a key ring holding the public key of an Ed25519 key with the ID "key", and the private key.
+func testKeyRing(t *testing.T) (*keyring.KeyRing, ed25519.PrivateKey) {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	jwks := keyring.JWKS{Keys: []keyring.JWK{{
+		KeyType:   "OKP",
+		KeyID:     "key",
+		Algorithm: keyring.AlgorithmEdDSA,
+		Use:       "sig",
+		Curve:     "Ed25519",
+		X:         base64.RawURLEncoding.EncodeToString(public),
+	}}}
+	keys := keyring.New(func(ctx context.Context) (keyring.JWKS, error) { return jwks, nil }, zap.NewNop())
+	if err := keys.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	return keys, private
+}
+
+// signToken returns a token for user 1 and session "session", expiring at expiresAt, signed by key with the method and kid.
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, expiresAt time.Time) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, &Claims{
+		UserID:         "1",
+		SessionID:      "session",
+		StandardClaims: jwt.StandardClaims{ExpiresAt: expiresAt.Unix()},
+	})
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+	return signed
+}
+
+// authenticate sends a request with the token through the middleware, and returns the response status.
+func authenticate(handler gin.HandlerFunc, token string) int {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/", handler, func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(&http.Cookie{Name: constants.Token, Value: token})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w.Code
+}
+
+// setNow replaces the clock of the middleware until the test finishes.
+func setNow(t *testing.T, clock *time.Time) {
+	now = func() time.Time { return *clock }
+	t.Cleanup(func() { now = time.Now })
+}
+
+func TestAuthenticateLocalRejectsUnexpectedKeysAndAlgorithms(t *testing.T) {
+	keys, private := testKeyRing(t)
+	users := &fakeValidator{checkSessionRes: &proto.CheckSessionRes{ErrorCode: -1, UserID: 1, Active: true}}
+	handler := Authenticate(&config.AuthConfig{Mode: config.AuthModeLocal, CacheTTL: 10, CacheSize: 10}, keys, users, zap.NewNop())
+	expiresAt := time.Now().Add(time.Minute)
+
+	if status := authenticate(handler, signToken(t, jwt.SigningMethodEdDSA, "key", private, expiresAt)); status != http.StatusOK {
+		t.Fatalf("valid token: got status %d, want %d", status, http.StatusOK)
+	}
+
+	_, other, _ := ed25519.GenerateKey(rand.Reader)
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"unknown kid", signToken(t, jwt.SigningMethodEdDSA, "other", private, expiresAt)},
+		{"wrong key", signToken(t, jwt.SigningMethodEdDSA, "key", other, expiresAt)},
+		{"HS256 with the public key as secret", signToken(t, jwt.SigningMethodHS256, "key", []byte(private.Public().(ed25519.PublicKey)), expiresAt)},
+		{"expired", signToken(t, jwt.SigningMethodEdDSA, "key", private, time.Now().Add(-time.Minute))},
+	}
+	for _, test := range tests {
+		if status := authenticate(handler, test.token); status != http.StatusUnauthorized {
+			t.Errorf("%s: got status %d, want %d", test.name, status, http.StatusUnauthorized)
+		}
+	}
+}
+
+func TestAuthenticateLocalCachesSessionStatus(t *testing.T) {
+	clock := time.Now()
+	setNow(t, &clock)
+	keys, private := testKeyRing(t)
+	users := &fakeValidator{checkSessionRes: &proto.CheckSessionRes{ErrorCode: -1, UserID: 1, Active: true}}
+	handler := Authenticate(&config.AuthConfig{Mode: config.AuthModeLocal, CacheTTL: 10, CacheSize: 10}, keys, users, zap.NewNop())
+	token := signToken(t, jwt.SigningMethodEdDSA, "key", private, time.Now().Add(time.Hour))
+
+	authenticate(handler, token)
+	authenticate(handler, token)
+	if users.checks != 1 {
+		t.Errorf("within the cache TTL: got %d session checks, want 1", users.checks)
+	}
+
+	// the session is revoked, which is seen once the cached status expires
+	users.checkSessionRes = &proto.CheckSessionRes{ErrorCode: -1, UserID: 1, Active: false}
+	clock = clock.Add(10 * time.Second)
+	if status := authenticate(handler, token); status != http.StatusUnauthorized {
+		t.Errorf("revoked session: got status %d, want %d", status, http.StatusUnauthorized)
+	}
+	if users.checks != 2 {
+		t.Errorf("after the cache TTL: got %d session checks, want 2", users.checks)
+	}
+}
+
+func TestAuthenticateIntrospectCacheExpiry(t *testing.T) {
+	clock := time.Now()
+	setNow(t, &clock)
+	users := &fakeValidator{validateTokenRes: &proto.ValidateTokenRes{
+		ErrorCode: -1,
+		Active:    true,
+		UserID:    1,
+		SessionID: "session",
+		ExpiresAt: clock.Add(5 * time.Second).Unix(),
+	}}
+	handler := Authenticate(&config.AuthConfig{Mode: config.AuthModeIntrospect, CacheTTL: 60, CacheSize: 10}, nil, users, zap.NewNop())
+
+	if status := authenticate(handler, "token"); status != http.StatusOK {
+		t.Fatalf("active token: got status %d, want %d", status, http.StatusOK)
+	}
+	authenticate(handler, "token")
+	if users.validations != 1 {
+		t.Errorf("within the cache TTL: got %d validations, want 1", users.validations)
+	}
+
+	// the result is not cached past the token's expiry, even though the cache TTL is longer
+	users.validateTokenRes = &proto.ValidateTokenRes{ErrorCode: constants.UserServiceTokenInvalid}
+	clock = clock.Add(6 * time.Second)
+	if status := authenticate(handler, "token"); status != http.StatusUnauthorized {
+		t.Errorf("expired token: got status %d, want %d", status, http.StatusUnauthorized)
+	}
+	if users.validations != 2 {
+		t.Errorf("after the token's expiry: got %d validations, want 2", users.validations)
+	}
+}
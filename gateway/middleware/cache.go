@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"sync"
+	"time"
+)
+
+// now returns the current time. It is replaced in tests.
+var now = time.Now
+
+// cachedResult is the result of validating a token or checking a session, kept until it expires.
+type cachedResult struct {
+	claims    *Claims
+	errorCode int32
+	expiresAt time.Time
+}
+
+// resultCache is a small cache of token validation or session check results. It is safe for concurrent use.
+// When the cache is full, expired results are evicted first, and then an arbitrary result.
+type resultCache struct {
+	mu      sync.Mutex
+	size    int
+	results map[string]cachedResult
+}
+
+// newResultCache returns a cache that holds at most size results.
+func newResultCache(size int) *resultCache {
+	return &resultCache{
+		size:    size,
+		results: make(map[string]cachedResult, size),
+	}
+}
+
+// get returns the result cached for key, if it has not expired.
+func (r *resultCache) get(key string) (cachedResult, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result, ok := r.results[key]
+	if !ok {
+		return cachedResult{}, false
+	}
+	if !now().Before(result.expiresAt) {
+		delete(r.results, key)
+		return cachedResult{}, false
+	}
+	return result, true
+}
+
+// set caches the result for key, evicting a result if the cache is full.
+func (r *resultCache) set(key string, result cachedResult) {
+	if r.size <= 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.results[key]; !ok && len(r.results) >= r.size {
+		r.evict()
+	}
+	r.results[key] = result
+}
+
+// evict removes the expired results, or an arbitrary result if none have expired. The lock must be held.
+func (r *resultCache) evict() {
+	t := now()
+	for key, result := range r.results {
+		if !t.Before(result.expiresAt) {
+			delete(r.results, key)
+		}
+	}
+	if len(r.results) < r.size {
+		return
+	}
+	for key := range r.results {
+		delete(r.results, key)
+		return
+	}
+}
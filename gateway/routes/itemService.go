@@ -10,6 +10,19 @@ import (
 // ItemServiceRoutes define routes used by the item service.
 func ItemServiceRoutes(g *gin.RouterGroup, controller *controllers.ItemServiceController, apis *config.ItemServiceAPIs) {
 	g.POST(apis.AddFav.Endpoint, controller.AddFavHandler)
+	g.PUT(apis.UpdateFav.Endpoint, controller.UpdateFavHandler)
 	g.GET(apis.GetFavList.Endpoint, controller.GetFavListHandler)
 	g.DELETE(apis.DeleteFav.Endpoint, controller.DeleteFavHandler)
+	g.GET(apis.GetItemPriceHistory.Endpoint, controller.GetItemPriceHistoryHandler)
+	g.POST(apis.CreateCollection.Endpoint, controller.CreateCollectionHandler)
+	g.GET(apis.ListCollections.Endpoint, controller.ListCollectionsHandler)
+	g.PUT(apis.RenameCollection.Endpoint, controller.RenameCollectionHandler)
+	g.DELETE(apis.DeleteCollection.Endpoint, controller.DeleteCollectionHandler)
+	g.PUT(apis.MoveFav.Endpoint, controller.MoveFavHandler)
+	g.PUT(apis.AnnotateFav.Endpoint, controller.AnnotateFavHandler)
+	g.GET(apis.ListTags.Endpoint, controller.ListTagsHandler)
+	g.POST(apis.BatchAddFav.Endpoint, controller.BatchAddFavHandler)
+	g.DELETE(apis.BatchDeleteFav.Endpoint, controller.BatchDeleteFavHandler)
+	g.GET(apis.ExportFavourites.Endpoint, controller.ExportFavouritesHandler)
+	g.POST(apis.ImportFavourites.Endpoint, controller.ImportFavouritesHandler)
 }
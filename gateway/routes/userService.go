@@ -8,7 +8,12 @@ import (
 )
 
 // UserServiceRoutes defines routes used by the user service.
-func UserServiceRoutes(g *gin.RouterGroup, controller *controllers.UserServiceController, apis *config.UserServiceAPIs) {
+// Routes for a logged in user are authenticated by the authenticate middleware.
+func UserServiceRoutes(g *gin.RouterGroup, controller *controllers.UserServiceController, apis *config.UserServiceAPIs, authenticate gin.HandlerFunc) {
 	g.POST(apis.Signup.Endpoint, controller.SignupHandler)
 	g.POST(apis.Login.Endpoint, controller.LoginHandler)
+	g.DELETE(apis.DeleteAccount.Endpoint, authenticate, controller.DeleteAccountHandler)
+	g.POST(apis.Refresh.Endpoint, controller.RefreshHandler)
+	g.POST(apis.Logout.Endpoint, authenticate, controller.LogoutHandler)
+	g.POST(apis.LogoutAll.Endpoint, authenticate, controller.LogoutAllHandler)
 }
@@ -0,0 +1,133 @@
+package alerts
+
+import (
+	"context"
+	config "itemService/config"
+	constants "itemService/constants"
+	db "itemService/db"
+	metrics "itemService/metrics"
+	pb "itemService/proto"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const (
+	evaluate = "alerts.Evaluate"
+)
+
+// Evaluator checks the alert rules of an item's favourites against the item's fresh price,
+// and notifies the users whose rule the price meets.
+// A user is alerted once per drop: they are alerted again only if the price falls further,
+// or after it rises back above their rule.
+type Evaluator struct {
+	favourites db.FavouritesRepository
+	notifier   Notifier
+	timeout    time.Duration
+	logger     *zap.Logger
+}
+
+// NewEvaluator returns an Evaluator that reads and updates the alert rules in the repository and delivers alerts with the notifier.
+func NewEvaluator(favourites db.FavouritesRepository, notifier Notifier, cfg *config.AlertsConfig, logger *zap.Logger) *Evaluator {
+	return &Evaluator{
+		favourites: favourites,
+		notifier:   notifier,
+		timeout:    time.Duration(cfg.Timeout) * time.Millisecond,
+		logger:     logger,
+	}
+}
+
+// Evaluate checks the alert rules of the item's favourites against the item's price and delivers the alerts.
+// Errors are logged and not returned, since alerts are best effort and do not affect the item being served.
+func (e *Evaluator) Evaluate(ctx context.Context, item *pb.Item) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, evaluate)
+	defer span.Finish()
+	if e.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.timeout)
+		defer cancel()
+	}
+
+	favourites, err := e.favourites.ListAlerting(ctx, item.ItemID, item.ShopID)
+	if err != nil {
+		e.logger.Error(
+			constants.ErrorDatabaseQueryMsg,
+			zap.String(constants.OpName, constants.ListAlerting),
+			zap.Int64(constants.ItemID, item.ItemID),
+			zap.Int64(constants.ShopID, item.ShopID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	for _, fav := range favourites {
+		if !fav.Triggered(item.Price) {
+			if fav.AlertedPrice != 0 {
+				// the price rose back above the rule, so that the next drop is alerted again
+				e.setAlertedPrice(ctx, fav, 0)
+			}
+			continue
+		}
+		if fav.AlertedPrice != 0 && item.Price >= fav.AlertedPrice {
+			// the user was already alerted at this price or lower
+			continue
+		}
+
+		// claim the alert before delivering it, so that a concurrent evaluation of the price does not deliver it too
+		claimed, err := e.favourites.ClaimAlert(ctx, fav.ID, item.Price)
+		if err != nil {
+			e.logger.Error(
+				constants.ErrorDatabaseUpdateMsg,
+				zap.String(constants.OpName, constants.ClaimAlert),
+				zap.Int64(constants.ID, fav.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+		if !claimed {
+			// the alert was claimed since the favourites were listed
+			continue
+		}
+
+		err = e.notifier.Notify(ctx, Alert{
+			UserID:      fav.UserID,
+			ItemID:      fav.ItemID,
+			ShopID:      fav.ShopID,
+			Name:        item.Name,
+			Price:       item.Price,
+			TargetPrice: fav.TargetPrice,
+			DropPercent: fav.DropPercent,
+			BasePrice:   fav.BasePrice,
+		})
+		if err != nil {
+			metrics.AlertsSent.WithLabelValues(e.notifier.Name(), constants.Failed).Inc()
+			e.logger.Error(
+				constants.ErrorSendAlertMsg,
+				zap.String(constants.Notifier, e.notifier.Name()),
+				zap.Int64(constants.UserID, fav.UserID),
+				zap.Int64(constants.ItemID, fav.ItemID),
+				zap.Int64(constants.ShopID, fav.ShopID),
+				zap.Error(err),
+			)
+			// give the claim back, so that the alert is retried on the next evaluation
+			e.setAlertedPrice(ctx, fav, fav.AlertedPrice)
+			continue
+		}
+		metrics.AlertsSent.WithLabelValues(e.notifier.Name(), constants.Sent).Inc()
+	}
+}
+
+// setAlertedPrice sets the price the favourite's user was alerted at. Failing to set it is logged.
+func (e *Evaluator) setAlertedPrice(ctx context.Context, fav db.Favourite, price int64) {
+	err := e.favourites.SetAlertedPrice(ctx, fav.ID, price)
+	if err != nil {
+		e.logger.Error(
+			constants.ErrorDatabaseUpdateMsg,
+			zap.String(constants.OpName, constants.SetAlertedPrice),
+			zap.Int64(constants.ID, fav.ID),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,119 @@
+package alerts
+
+import (
+	"context"
+	config "itemService/config"
+	db "itemService/db"
+	pb "itemService/proto"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestEvaluate(t *testing.T) {
+	ctx := context.Background()
+	repo := db.NewMemoryRepository()
+	// user 1 wants the price at 80 or lower, user 2 a 10% drop from 100, user 3 no alert
+	repo.Add(ctx, 1, 100, 200, db.ItemSnapshot{}, db.AlertRule{TargetPrice: 80, BasePrice: 100})
+	repo.Add(ctx, 2, 100, 200, db.ItemSnapshot{}, db.AlertRule{DropPercent: 10, BasePrice: 100})
+	repo.Add(ctx, 3, 100, 200, db.ItemSnapshot{}, db.AlertRule{})
+
+	notifier := &recordingNotifier{}
+	e := NewEvaluator(repo, notifier, &config.AlertsConfig{Timeout: 1000}, zap.NewNop())
+	evaluate := func(price int64) []int64 {
+		before := len(notifier.Alerts())
+		e.Evaluate(ctx, &pb.Item{ItemID: 100, ShopID: 200, Price: price})
+		var users []int64
+		for _, alert := range notifier.Alerts()[before:] {
+			users = append(users, alert.UserID)
+		}
+		return users
+	}
+
+	steps := []struct {
+		price int64
+		want  []int64
+	}{
+		{95, nil},
+		// a 10% drop meets user 2's rule
+		{90, []int64{2}},
+		// no alert for the same price again
+		{90, nil},
+		// a further drop meets both rules, and user 2 is alerted again as the price is lower
+		{80, []int64{1, 2}},
+		// the price rising back above both rules rearms them
+		{100, nil},
+		{80, []int64{1, 2}},
+	}
+	for _, step := range steps {
+		got := evaluate(step.price)
+		if !sameUsers(got, step.want) {
+			t.Errorf("price %d: got alerts for users %v, want %v", step.price, got, step.want)
+		}
+	}
+}
+
+func TestEvaluateConcurrentlyAlertsOnce(t *testing.T) {
+	ctx := context.Background()
+	repo := db.NewMemoryRepository()
+	repo.Add(ctx, 1, 100, 200, db.ItemSnapshot{}, db.AlertRule{TargetPrice: 80, BasePrice: 100})
+
+	notifier := &recordingNotifier{}
+	e := NewEvaluator(repo, notifier, &config.AlertsConfig{Timeout: 1000}, zap.NewNop())
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e.Evaluate(ctx, &pb.Item{ItemID: 100, ShopID: 200, Price: 80})
+		}()
+	}
+	wg.Wait()
+
+	if got := len(notifier.Alerts()); got != 1 {
+		t.Errorf("concurrent evaluations: got %d alerts, want 1", got)
+	}
+}
+
+// recordingNotifier keeps the alerts it is asked to deliver.
+type recordingNotifier struct {
+	mu     sync.Mutex
+	alerts []Alert
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, alert Alert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+func (n *recordingNotifier) Name() string {
+	return "recording"
+}
+
+// Alerts returns a copy of the alerts delivered, the oldest first.
+func (n *recordingNotifier) Alerts() []Alert {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]Alert(nil), n.alerts...)
+}
+
+// sameUsers reports whether a and b hold the same users, in any order.
+func sameUsers(a []int64, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[int64]int)
+	for _, u := range a {
+		seen[u]++
+	}
+	for _, u := range b {
+		seen[u]--
+		if seen[u] < 0 {
+			return false
+		}
+	}
+	return true
+}
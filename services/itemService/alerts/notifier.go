@@ -0,0 +1,112 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	config "itemService/config"
+	constants "itemService/constants"
+	"itemService/external"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// NotifierLog selects the LogNotifier
+	NotifierLog = "log"
+	// NotifierWebhook selects the WebhookNotifier
+	NotifierWebhook = "webhook"
+)
+
+// Alert tells a user that the price of a favourite dropped to meet the favourite's alert rule.
+type Alert struct {
+	UserID      int64  `json:"userID"`
+	ItemID      int64  `json:"itemID"`
+	ShopID      int64  `json:"shopID"`
+	Name        string `json:"name"`
+	Price       int64  `json:"price"`
+	TargetPrice int64  `json:"targetPrice,omitempty"`
+	DropPercent int32  `json:"dropPercent,omitempty"`
+	BasePrice   int64  `json:"basePrice,omitempty"`
+}
+
+// Notifier delivers alerts to users.
+type Notifier interface {
+	// Notify delivers the alert. It returns an error if the alert could not be delivered.
+	Notify(ctx context.Context, alert Alert) error
+	// Name identifies the notifier in logs and metrics.
+	Name() string
+}
+
+// NewNotifier returns the notifier selected in the config. The LogNotifier is used unless the webhook is selected.
+func NewNotifier(cfg *config.AlertsConfig, logger *zap.Logger) Notifier {
+	if cfg.Notifier == NotifierWebhook {
+		return NewWebhookNotifier(cfg.Webhook.URL, external.NewHTTPClient(&cfg.Webhook.HTTP, logger))
+	}
+	return NewLogNotifier(logger)
+}
+
+// LogNotifier logs the alerts. It is used for local development.
+type LogNotifier struct {
+	logger *zap.Logger
+}
+
+// NewLogNotifier returns a LogNotifier that logs with the logger.
+func NewLogNotifier(logger *zap.Logger) *LogNotifier {
+	return &LogNotifier{
+		logger: logger,
+	}
+}
+
+// Notify logs the alert.
+func (n *LogNotifier) Notify(ctx context.Context, alert Alert) error {
+	n.logger.Info(
+		constants.InfoAlertSent,
+		zap.Int64(constants.UserID, alert.UserID),
+		zap.Int64(constants.ItemID, alert.ItemID),
+		zap.Int64(constants.ShopID, alert.ShopID),
+		zap.Int64(constants.Price, alert.Price),
+	)
+	return nil
+}
+
+// Name returns NotifierLog.
+func (n *LogNotifier) Name() string {
+	return NotifierLog
+}
+
+// WebhookNotifier posts each alert as JSON to a webhook.
+type WebhookNotifier struct {
+	url        string
+	httpClient *external.HTTPClient
+}
+
+// NewWebhookNotifier returns a WebhookNotifier that posts to the url with the HTTP client.
+func NewWebhookNotifier(url string, httpClient *external.HTTPClient) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+// Notify posts the alert to the webhook. Any status other than 2xx is an error.
+func (n *WebhookNotifier) Notify(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	_, status, err := n.httpClient.Post(ctx, n.url, n.url, body)
+	if err != nil {
+		return err
+	}
+	if status < 200 || status > 299 {
+		return fmt.Errorf("webhook responded with status %d", status)
+	}
+	return nil
+}
+
+// Name returns NotifierWebhook.
+func (n *WebhookNotifier) Name() string {
+	return NotifierWebhook
+}
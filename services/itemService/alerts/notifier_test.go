@@ -0,0 +1,45 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	config "itemService/config"
+	"itemService/external"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	var got Alert
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("got method %s, want POST", r.Method)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decoding alert: %v", err)
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	httpClient := external.NewHTTPClient(&config.HTTPClientConfig{Timeout: 1000}, zap.NewNop())
+	n := NewWebhookNotifier(server.URL, httpClient)
+	alert := Alert{UserID: 1, ItemID: 100, ShopID: 200, Name: "item", Price: 80, TargetPrice: 80}
+
+	if err := n.Notify(context.Background(), alert); err != nil {
+		t.Fatalf("Notify: got error %v", err)
+	}
+	if got != alert {
+		t.Errorf("got alert %+v, want %+v", got, alert)
+	}
+
+	// a non 2xx status is not a delivery
+	status = http.StatusInternalServerError
+	if err := n.Notify(context.Background(), alert); err == nil {
+		t.Error("Notify with status 500: got nil error")
+	}
+}
@@ -0,0 +1,29 @@
+// Command fakeshopee runs the fake Shopee API server for local development.
+// Set external.shopee.getItem.endpoint to http://localhost:<port>/api/v4/item/get to use it.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"itemService/external/shopee/shopeetest"
+)
+
+func main() {
+	addr := flag.String("addr", ":7080", "address to listen on")
+	fixturesPath := flag.String("fixtures", "", "path to a JSON file of fixtures, the bundled fixtures are used if empty")
+	flag.Parse()
+
+	fixtures := shopeetest.DefaultFixtures()
+	if *fixturesPath != "" {
+		var err error
+		fixtures, err = shopeetest.LoadFixtures(*fixturesPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	log.Printf("serving %d fixtures on %s%s", len(fixtures), *addr, shopeetest.GetItemPath)
+	log.Fatal(http.ListenAndServe(*addr, shopeetest.NewHandler(fixtures...)))
+}
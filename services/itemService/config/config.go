@@ -11,11 +11,50 @@ type Config struct {
 	Port             string           `mapstructure:port`
 	ServiceLabel     string           `mapstructure:serviceLabel`
 	MaxPerPage       int              `mapstructure:maxPerPage`
+	MaxPageSize      int              `mapstructure:"maxPageSize"`
+	MaxBatchSize     int              `mapstructure:"maxBatchSize"`
+	MaxImportSize    int              `mapstructure:"maxImportSize"`
+	MaxCollections   int              `mapstructure:"maxCollections"`
+	FetchTimeout     int              `mapstructure:"fetchTimeout"`
 	DbConfig         DbConfig         `mapstructure:db`
 	RedisConfig      RedisConfig      `mapstructure:redis`
 	ExternalConfig   ExternalConfig   `mapstructure:external`
 	PrometheusConfig PrometheusConfig `mapstructure:prometheus`
 	JaegerConfig     JaegerConfig     `mapstructure:jaeger`
+	RefresherConfig  RefresherConfig  `mapstructure:"refresher"`
+	AlertsConfig     AlertsConfig     `mapstructure:"alerts"`
+}
+
+// AlertsConfig holds configurations for the price-drop alerts sent to users.
+type AlertsConfig struct {
+	// Notifier is the notifier that delivers alerts, either "log" or "webhook"
+	Notifier string `mapstructure:"notifier"`
+	// Timeout is the max time spent evaluating and delivering the alerts for a price, in milliseconds
+	Timeout int           `mapstructure:"timeout"`
+	Webhook WebhookConfig `mapstructure:"webhook"`
+}
+
+// WebhookConfig holds configurations for the webhook that alerts are posted to.
+type WebhookConfig struct {
+	URL  string           `mapstructure:"url"`
+	HTTP HTTPClientConfig `mapstructure:"http"`
+}
+
+// RefresherConfig holds configurations for the background worker that refreshes cached items before they expire.
+type RefresherConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Interval is the time between runs, in seconds
+	Interval int `mapstructure:"interval"`
+	// Candidates is the number of most favourited items checked in each run
+	Candidates int `mapstructure:"candidates"`
+	// RefreshBefore is how close to expiry an item is refreshed, in seconds
+	RefreshBefore int `mapstructure:"refreshBefore"`
+	// Concurrency is the number of items refreshed at the same time
+	Concurrency int `mapstructure:"concurrency"`
+	// RateLimit is the max number of items refreshed per second
+	RateLimit int `mapstructure:"rateLimit"`
+	// Budget is the max number of items refreshed in each run
+	Budget int `mapstructure:"budget"`
 }
 
 // DbConfig holds configurations for the database.
@@ -28,6 +67,7 @@ type DbConfig struct {
 	Net          string `mapstructure:net`
 	DbName       string `mapstructure:dbName`
 	Password     string `mapstructure:password`
+	Backend      string `mapstructure:"backend"`
 }
 
 // RedisConfig holds configurations for redis
@@ -38,6 +78,12 @@ type RedisConfig struct {
 	Password     string `mapstructure:password`
 	Db           int    `mapstructure:db`
 	Expire       int    `mapstructure:expire`
+	// LocalSize and LocalExpire bound the in-process cache in front of redis
+	LocalSize   int `mapstructure:"localSize"`
+	LocalExpire int `mapstructure:"localExpire"`
+	StaleExpire int `mapstructure:"staleExpire"`
+	// NegativeExpire is the expiry of the entries for items the external provider reports as missing
+	NegativeExpire int `mapstructure:"negativeExpire"`
 }
 
 // ExternalConfig holds configurations for external services
@@ -47,7 +93,46 @@ type ExternalConfig struct {
 
 // Shopee holds config for external requests to Shopee
 type Shopee struct {
-	GetItem API `mapstructure:getItem`
+	GetItem API              `mapstructure:getItem`
+	HTTP    HTTPClientConfig `mapstructure:"http"`
+	Retry   RetryConfig      `mapstructure:"retry"`
+	Breaker BreakerConfig    `mapstructure:"breaker"`
+}
+
+// BreakerConfig holds the thresholds of a circuit breaker around an external dependency.
+type BreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens the breaker
+	FailureThreshold int `mapstructure:"failureThreshold"`
+	// OpenTimeout is how long the breaker stays open before letting trial requests through, in milliseconds
+	OpenTimeout int `mapstructure:"openTimeout"`
+	// HalfOpenMaxRequests is the number of concurrent trial requests allowed while half-open
+	HalfOpenMaxRequests int `mapstructure:"halfOpenMaxRequests"`
+	// SuccessThreshold is the number of consecutive successful trial requests that closes the breaker
+	SuccessThreshold int `mapstructure:"successThreshold"`
+}
+
+// RetryConfig holds the retry policy for external requests. Backoffs are in milliseconds.
+type RetryConfig struct {
+	MaxAttempts int `mapstructure:"maxAttempts"`
+	BaseBackoff int `mapstructure:"baseBackoff"`
+	MaxBackoff  int `mapstructure:"maxBackoff"`
+	// Jitter is the fraction of each backoff that is randomised, between 0 and 1
+	Jitter              float64 `mapstructure:"jitter"`
+	RetryableErrorCodes []int   `mapstructure:"retryableErrorCodes"`
+	RetryableStatuses   []int   `mapstructure:"retryableStatuses"`
+}
+
+// HTTPClientConfig holds the timeouts and connection pool limits of a HTTP client for external requests.
+// Timeouts are in milliseconds.
+type HTTPClientConfig struct {
+	Timeout               int `mapstructure:"timeout"`
+	DialTimeout           int `mapstructure:"dialTimeout"`
+	TLSHandshakeTimeout   int `mapstructure:"tlsHandshakeTimeout"`
+	ResponseHeaderTimeout int `mapstructure:"responseHeaderTimeout"`
+	IdleConnTimeout       int `mapstructure:"idleConnTimeout"`
+	MaxIdleConns          int `mapstructure:"maxIdleConns"`
+	MaxIdleConnsPerHost   int `mapstructure:"maxIdleConnsPerHost"`
+	MaxConnsPerHost       int `mapstructure:"maxConnsPerHost"`
 }
 
 // API defines a HTTP endpoint used when making external requests
@@ -111,6 +196,24 @@ func LoadConfig(logger *zap.Logger) (*Config, error) {
 		return nil, err
 	}
 
+	err = viper.UnmarshalKey("refresher", &config.RefresherConfig)
+	if err != nil {
+		logger.Fatal(
+			"Unable to unmarshal into refresherconfig struct",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	err = viper.UnmarshalKey("alerts", &config.AlertsConfig)
+	if err != nil {
+		logger.Fatal(
+			"Unable to unmarshal into alertsconfig struct",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
 	err = viper.UnmarshalKey("prometheus", &config.PrometheusConfig)
 	if err != nil {
 		logger.Fatal(
@@ -9,10 +9,14 @@ const (
 	ShopID = "shopID"
 	// Query string
 	Query = "query"
+	// Args string
+	Args = "args"
 	// Count string
 	Count = "count"
 	// Page string
 	Page = "page"
+	// Cursor string
+	Cursor = "cursor"
 	// Res string
 	Res = "res"
 	// ID string
@@ -23,8 +27,68 @@ const (
 	GetFavList = "getFavList"
 	// AddFav string
 	AddFav = "addFav"
+	// BatchAddFav string
+	BatchAddFav = "batchAddFav"
+	// BatchDeleteFav string
+	BatchDeleteFav = "batchDeleteFav"
+	// DeleteAllFav string
+	DeleteAllFav = "deleteAllFav"
+	// DeleteAllCollections string
+	DeleteAllCollections = "deleteAllCollections"
+	// ExportFavourites string
+	ExportFavourites = "exportFavourites"
+	// ImportFavourites string
+	ImportFavourites = "importFavourites"
+	// Format string
+	Format = "format"
 	// GetFavCount string
 	GetFavCount = "getFavCount"
+	// AddPricePoint string
+	AddPricePoint = "addPricePoint"
+	// GetPriceHistory string
+	GetPriceHistory = "getPriceHistory"
+	// GetItemPriceHistory string
+	GetItemPriceHistory = "getItemPriceHistory"
+	// Price string
+	Price = "price"
+	// AnnotateFav string
+	AnnotateFav = "annotateFav"
+	// SetNoteAndTags string
+	SetNoteAndTags = "setNoteAndTags"
+	// ListTags string
+	ListTags = "listTags"
+	// GetTags string
+	GetTags = "getTags"
+	// Tag string
+	Tag = "tag"
+	// UpdateItemSnapshot string
+	UpdateItemSnapshot = "updateItemSnapshot"
+	// MoveFav string
+	MoveFav = "moveFav"
+	// CreateCollection string
+	CreateCollection = "createCollection"
+	// GetCollection string
+	GetCollection = "getCollection"
+	// ListCollections string
+	ListCollections = "listCollections"
+	// RenameCollection string
+	RenameCollection = "renameCollection"
+	// DeleteCollection string
+	DeleteCollection = "deleteCollection"
+	// CollectionID string
+	CollectionID = "collectionID"
+	// Name string
+	Name = "name"
+	// UpdateFav string
+	UpdateFav = "updateFav"
+	// ListAlerting string
+	ListAlerting = "listAlerting"
+	// SetAlertedPrice string
+	SetAlertedPrice = "setAlertedPrice"
+	// ClaimAlert string
+	ClaimAlert = "claimAlert"
+	// TopFavourited string
+	TopFavourited = "topFavourited"
 	// Item string
 	Item = "item"
 	// NilErrorCode string
@@ -43,10 +107,16 @@ const (
 	Select = "SELECT"
 	// Delete string
 	Delete = "DELETE"
+	// Update string
+	Update = "UPDATE"
+	// Transaction string
+	Transaction = "TRANSACTION"
 	// OpName string
 	OpName = "opName"
 	// MySQL string
 	MySQL = "mysql"
+	// Memory string
+	Memory = "memory"
 	// True string
 	True = "true"
 	// False string
@@ -61,6 +131,48 @@ const (
 	Get = "GET"
 	// Set string
 	Set = "SET"
+	// MGet string
+	MGet = "MGET"
+	// Del string
+	Del = "DEL"
+	// Keys string
+	Keys = "keys"
 	// Endpoint string
 	Endpoint = "endpoint"
+	// StatusCode string
+	StatusCode = "statusCode"
+	// Attempt string
+	Attempt = "attempt"
+	// Backoff string
+	Backoff = "backoff"
+	// Reason string
+	Reason = "reason"
+	// Local string
+	Local = "local"
+	// Redis string
+	Redis = "redis"
+	// TTL string
+	TTL = "TTL"
+	// Refreshed string
+	Refreshed = "refreshed"
+	// Failed string
+	Failed = "failed"
+	// Skipped string
+	Skipped = "skipped"
+	// Hit string
+	Hit = "hit"
+	// Miss string
+	Miss = "miss"
+	// Breaker string
+	Breaker = "breaker"
+	// From string
+	From = "from"
+	// To string
+	To = "to"
+	// Shopee string
+	Shopee = "shopee"
+	// Notifier string
+	Notifier = "notifier"
+	// Sent string
+	Sent = "sent"
 )
@@ -7,6 +7,44 @@ const (
 
 	// ErrorItemInFavourites service error code
 	ErrorItemInFavourites = 340011
+	// ErrorItemUnavailable service error code
+	ErrorItemUnavailable = 340012
+	// ErrorInvalidTimeRange service error code
+	ErrorInvalidTimeRange = 340013
+	// ErrorInvalidAlertRule service error code
+	ErrorInvalidAlertRule = 340014
+	// ErrorItemNotInFavourites service error code
+	ErrorItemNotInFavourites = 340015
+	// ErrorInvalidCollectionName service error code
+	ErrorInvalidCollectionName = 340016
+	// ErrorCollectionExists service error code
+	ErrorCollectionExists = 340017
+	// ErrorCollectionNotFound service error code
+	ErrorCollectionNotFound = 340018
+	// ErrorTooManyCollections service error code
+	ErrorTooManyCollections = 340019
+	// ErrorInvalidNote service error code
+	ErrorInvalidNote = 340020
+	// ErrorInvalidTags service error code
+	ErrorInvalidTags = 340021
+	// ErrorInvalidSort service error code
+	ErrorInvalidSort = 340022
+	// ErrorInvalidPriceRange service error code
+	ErrorInvalidPriceRange = 340023
+	// ErrorInvalidCursor service error code
+	ErrorInvalidCursor = 340024
+	// ErrorInvalidPageSize service error code
+	ErrorInvalidPageSize = 340025
+	// ErrorInvalidBatchSize service error code
+	ErrorInvalidBatchSize = 340026
+	// ErrorInvalidFileFormat service error code
+	ErrorInvalidFileFormat = 340027
+	// ErrorInvalidImportFile service error code
+	ErrorInvalidImportFile = 340028
+	// ErrorImportTooLarge service error code
+	ErrorImportTooLarge = 340029
+	// ErrorInvalidPage service error code
+	ErrorInvalidPage = 340030
 
 	// 500 errors
 	// server errors
@@ -25,6 +63,8 @@ const (
 	ErrorDatabaseConnection = 350014
 	// ErrorDatabaseDelete service error code
 	ErrorDatabaseDelete = 350015
+	// ErrorDatabaseUpdate service error code
+	ErrorDatabaseUpdate = 350016
 
 	// ErrorRedis service error code
 	ErrorRedis = 350021
@@ -34,6 +74,8 @@ const (
 	ErrorRedisGet = 350023
 	// ErrorRedisSet service error code
 	ErrorRedisSet = 350024
+	// ErrorRedisDel service error code
+	ErrorRedisDel = 350025
 
 	// external calls
 
@@ -41,6 +83,8 @@ const (
 	ErrorExternalAPICall = 350031
 	// ErrorExternalShopeeAPICall service error code
 	ErrorExternalShopeeAPICall = 350032
+	// ErrorExternalCircuitOpen service error code
+	ErrorExternalCircuitOpen = 350033
 
 	// marshalling and unmarshalling
 
@@ -1,6 +1,55 @@
 package constants
 
 const (
+	// user parameters
+
+	// ErrorItemUnavailableMsg server error message
+	ErrorItemUnavailableMsg = "error_item_unavailable"
+	// ErrorInvalidTimeRangeMsg server error message
+	ErrorInvalidTimeRangeMsg = "error_invalid_time_range"
+	// ErrorInvalidAlertRuleMsg server error message
+	ErrorInvalidAlertRuleMsg = "error_invalid_alert_rule"
+	// ErrorItemNotInFavouritesMsg server error message
+	ErrorItemNotInFavouritesMsg = "error_item_not_in_favourites"
+	// ErrorInvalidCollectionNameMsg server error message
+	ErrorInvalidCollectionNameMsg = "error_invalid_collection_name"
+	// ErrorCollectionExistsMsg server error message
+	ErrorCollectionExistsMsg = "error_collection_exists"
+	// ErrorCollectionNotFoundMsg server error message
+	ErrorCollectionNotFoundMsg = "error_collection_not_found"
+	// ErrorTooManyCollectionsMsg server error message
+	ErrorTooManyCollectionsMsg = "error_too_many_collections"
+	// ErrorInvalidNoteMsg server error message
+	ErrorInvalidNoteMsg = "error_invalid_note"
+	// ErrorInvalidTagsMsg server error message
+	ErrorInvalidTagsMsg = "error_invalid_tags"
+	// ErrorInvalidSortMsg server error message
+	ErrorInvalidSortMsg = "error_invalid_sort"
+	// ErrorInvalidPriceRangeMsg server error message
+	ErrorInvalidPriceRangeMsg = "error_invalid_price_range"
+	// ErrorInvalidCursorMsg server error message
+	ErrorInvalidCursorMsg = "error_invalid_cursor"
+	// ErrorInvalidPageSizeMsg server error message
+	ErrorInvalidPageSizeMsg = "error_invalid_page_size"
+	// ErrorInvalidBatchSizeMsg server error message
+	ErrorInvalidBatchSizeMsg = "error_invalid_batch_size"
+	// ErrorInvalidFileFormatMsg server error message
+	ErrorInvalidFileFormatMsg = "error_invalid_file_format"
+	// ErrorInvalidImportFileMsg server error message
+	ErrorInvalidImportFileMsg = "error_invalid_import_file"
+	// ErrorImportTooLargeMsg server error message
+	ErrorImportTooLargeMsg = "error_import_too_large"
+	// ErrorInvalidPageMsg server error message
+	ErrorInvalidPageMsg = "error_invalid_page"
+	// ErrorExportMsg server error message
+	ErrorExportMsg = "error_export"
+	// ErrorGetItemMsg server error message
+	ErrorGetItemMsg = "error_get_item"
+	// ErrorRefreshItemMsg server error message
+	ErrorRefreshItemMsg = "error_refresh_item"
+	// ErrorSendAlertMsg server error message
+	ErrorSendAlertMsg = "error_send_alert"
+
 	// server error
 
 	// ErrorLoadConfigFailMsg server error message
@@ -13,6 +62,8 @@ const (
 	ErrorPromInitCustomMetricsMsg = "error_prom_init_custom_metrics"
 	// ErrorJaegerInitMsg service error message
 	ErrorJaegerInitMsg = "error_jaeger_init"
+	// ErrorTraceInjectMsg service error message
+	ErrorTraceInjectMsg = "error_trace_inject"
 
 	// database
 
@@ -24,6 +75,12 @@ const (
 	ErrorDatabaseQueryMsg = "error_database_query"
 	// ErrorDatabaseDeleteMsg server error message
 	ErrorDatabaseDeleteMsg = "error_database_delete"
+	// ErrorDatabaseUpdateMsg server error message
+	ErrorDatabaseUpdateMsg = "error_database_update"
+	// ErrorDatabaseTransactionMsg server error message
+	ErrorDatabaseTransactionMsg = "error_database_transaction"
+	// ErrorDatabasePrepareMsg server error message
+	ErrorDatabasePrepareMsg = "error_database_prepare"
 	// ErrorDatabaseConnectionMsg server error message
 	ErrorDatabaseConnectionMsg = "error_database_connection"
 	// ErrorRedisConnectionMsg server error message
@@ -32,6 +89,8 @@ const (
 	ErrorRedisGetMsg = "error_redis_get"
 	// ErrorRedisSetMsg server error message
 	ErrorRedisSetMsg = "error_redis_set"
+	// ErrorRedisDelMsg server error message
+	ErrorRedisDelMsg = "error_redis_del"
 
 	// external calls
 
@@ -39,6 +98,8 @@ const (
 	ErrorExternalAPICallMsg = "error_external_api_call"
 	// ErrorExternalShopeeAPICallMsg server error message
 	ErrorExternalShopeeAPICallMsg = "error_external_shopee_api_call"
+	// ErrorExternalCircuitOpenMsg server error message
+	ErrorExternalCircuitOpenMsg = "error_external_circuit_open"
 
 	// marshalling / unmarshalling
 
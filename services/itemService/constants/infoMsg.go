@@ -26,6 +26,10 @@ const (
 	InfoDatabaseConnectSuccess = "info_db_connect_success"
 	// InfoDatabaseDelete info for logging
 	InfoDatabaseDelete = "info_db_delete"
+	// InfoDatabaseUpdate info for logging
+	InfoDatabaseUpdate = "info_db_update"
+	// InfoDatabaseMemoryBackend info for logging
+	InfoDatabaseMemoryBackend = "info_db_memory_backend"
 
 	// InfoRedisConnectSuccess info for logging
 	InfoRedisConnectSuccess = "info_redis_connect_success"
@@ -33,18 +37,52 @@ const (
 	InfoRedisSet = "info_redis_set"
 	// InfoRedisGet info for logging
 	InfoRedisGet = "info_redis_get"
+	// InfoRedisDel info for logging
+	InfoRedisDel = "info_redis_del"
 	// InfoItemNotInRedis info for logging
 	InfoItemNotInRedis = "info_redis_item_not_found"
 
 	// InfoExternalAPICall info for logging
 	InfoExternalAPICall = "info_External_api_call"
+	// InfoExternalAPIRetry info for logging
+	InfoExternalAPIRetry = "info_external_api_retry"
+	// InfoCircuitBreakerStateChange info for logging
+	InfoCircuitBreakerStateChange = "info_circuit_breaker_state_change"
+	// InfoItemServedStale info for logging
+	InfoItemServedStale = "info_item_served_stale"
+	// InfoRefresherStart info for logging
+	InfoRefresherStart = "info_refresher_start"
+	// InfoRefresherStop info for logging
+	InfoRefresherStop = "info_refresher_stop"
+	// InfoRefresherRun info for logging
+	InfoRefresherRun = "info_refresher_run"
+	// InfoServerShutdown info for logging
+	InfoServerShutdown = "info_server_shutdown"
+	// InfoItemUnavailable info for logging
+	InfoItemUnavailable = "info_item_unavailable"
 
 	// queries
 
 	// InfoFavouriteAdded info for logging
 	InfoFavouriteAdded = "info_favourite_added"
+	// InfoFavouritesBatchAdded info for logging
+	InfoFavouritesBatchAdded = "info_favourites_batch_added"
+	// InfoFavouritesBatchDeleted info for logging
+	InfoFavouritesBatchDeleted = "info_favourites_batch_deleted"
+	// InfoFavouritesPurged info for logging
+	InfoFavouritesPurged = "info_favourites_purged"
+	// InfoFavouritesExported info for logging
+	InfoFavouritesExported = "info_favourites_exported"
+	// InfoFavouritesImported info for logging
+	InfoFavouritesImported = "info_favourites_imported"
 	// InfoItemNotInFavourites info for logging
 	InfoItemNotInFavourites = "info_item_not_in_favourites"
+	// InfoPriceRecorded info for logging
+	InfoPriceRecorded = "info_price_recorded"
 	// InfoItemInFavourites info for logging
 	InfoItemInFavourites = "info_item_in_favourites"
+	// InfoAlertSent info for logging
+	InfoAlertSent = "info_alert_sent"
+	// InfoCollectionCreated info for logging
+	InfoCollectionCreated = "info_collection_created"
 )
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache is implemented by the caches of item information, such as the TieredCache in front of redis.
+// A value of nil means the key is not in the cache.
+type Cache interface {
+	// Get returns the value of the key, or nil if the key is not in the cache.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// MGet returns the values of the keys in the same order as the keys. The value of a key that is not in the cache is nil.
+	MGet(ctx context.Context, keys []string) ([][]byte, error)
+	// Set sets the value of the key. exp is used to define an expiry, and 0 means no expiry.
+	Set(ctx context.Context, key string, bytes []byte, exp time.Duration) error
+	// Del removes the keys from the cache.
+	Del(ctx context.Context, keys ...string) error
+	// TTL returns the remaining time to live of the key.
+	// It returns -2 if the key is not in the cache, and -1 if the key has no expiry.
+	TTL(ctx context.Context, key string) (time.Duration, error)
+}
+
+// MemoryCache is a Cache that keeps values in memory, used to run the service without redis, e.g. in tests.
+// Expired values are removed when they are read. It is safe for concurrent use.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+	now     func() time.Time
+}
+
+type memoryEntry struct {
+	value []byte
+	// expiresAt is the zero time if the entry has no expiry
+	expiresAt time.Time
+}
+
+// NewMemoryCache returns an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]memoryEntry),
+		now:     time.Now,
+	}
+}
+
+// Get returns the value of the key, or nil if the key is not in the cache.
+func (c *MemoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.get(key)
+	if !ok {
+		return nil, nil
+	}
+	return entry.value, nil
+}
+
+// MGet returns the values of the keys in the same order as the keys.
+func (c *MemoryCache) MGet(ctx context.Context, keys []string) ([][]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		if entry, ok := c.get(key); ok {
+			values[i] = entry.value
+		}
+	}
+	return values, nil
+}
+
+// Set sets the value of the key, which expires after exp if exp is positive.
+func (c *MemoryCache) Set(ctx context.Context, key string, bytes []byte, exp time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := memoryEntry{value: bytes}
+	if exp > 0 {
+		entry.expiresAt = c.now().Add(exp)
+	}
+	c.entries[key] = entry
+	return nil
+}
+
+// Del removes the keys from the cache.
+func (c *MemoryCache) Del(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+	return nil
+}
+
+// TTL returns the remaining time to live of the key, -2 if the key is not in the cache, and -1 if the key has no expiry.
+func (c *MemoryCache) TTL(ctx context.Context, key string) (time.Duration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.get(key)
+	if !ok {
+		return -2, nil
+	}
+	if entry.expiresAt.IsZero() {
+		return -1, nil
+	}
+	return entry.expiresAt.Sub(c.now()), nil
+}
+
+// get returns the entry of the key if it has not expired, and removes it if it has. The caller must hold the lock.
+func (c *MemoryCache) get(key string) (memoryEntry, bool) {
+	entry, ok := c.entries[key]
+	if !ok {
+		return entry, false
+	}
+	if !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return entry, false
+	}
+	return entry, true
+}
@@ -0,0 +1,14 @@
+package db
+
+import "time"
+
+// Collection is a named list of a user's favourites. It follows the schema in schema/mysql.sql
+// A favourite is in at most one collection.
+type Collection struct {
+	ID          int64
+	UserID      int64
+	Name        string
+	TimeCreated time.Time
+	// Count is the number of favourites in the collection. It is only set when listing collections
+	Count int
+}
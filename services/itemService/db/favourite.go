@@ -9,4 +9,118 @@ type Favourite struct {
 	ItemID    int64
 	ShopID    int64
 	TimeAdded time.Time
+	// CollectionID is the ID of the collection the favourite is in, or 0 if it is not in a collection
+	CollectionID int64
+	// Note is the user's free-text note on the favourite
+	Note string
+	// Tags are the user's tags on the favourite, sorted
+	Tags []string
+	// Item is the item's information when it was last fetched, kept so that favourites can be sorted and filtered by it
+	Item ItemSnapshot
+	AlertRule
+	// AlertedPrice is the price the user was last alerted at, or 0 if the user has not been alerted
+	AlertedPrice int64
+}
+
+// ItemSnapshot is a copy of an item's information, denormalised into each favourite of the item.
+type ItemSnapshot struct {
+	Name  string
+	Price int64
+}
+
+// NewFavourite is an item to add to a user's favourites, with the item's information, the user's note and tags, and the alert rule.
+type NewFavourite struct {
+	ItemID int64
+	ShopID int64
+	// TimeAdded is the time the favourite was added, or the zero time to add it now
+	TimeAdded time.Time
+	Item      ItemSnapshot
+	Note      string
+	Tags      []string
+	AlertRule
+}
+
+// ItemKey identifies an item.
+type ItemKey struct {
+	ItemID int64
+	ShopID int64
+}
+
+// FavouriteFilter selects the favourites to list. The zero value selects all of a user's favourites.
+type FavouriteFilter struct {
+	// CollectionID selects the favourites in the collection, if it is not 0
+	CollectionID int64
+	// Tag selects the favourites with the tag, if it is not empty
+	Tag string
+	// ShopID selects the favourites of the shop's items, if it is not 0
+	ShopID int64
+	// MinPrice and MaxPrice select the favourites with an item price in the range, inclusive. A bound of 0 is not applied
+	MinPrice int64
+	MaxPrice int64
+}
+
+// FavouriteSort is the order favourites are listed in.
+type FavouriteSort int
+
+const (
+	// SortAddedDesc lists the latest added favourite first
+	SortAddedDesc FavouriteSort = iota
+	// SortAddedAsc lists the earliest added favourite first
+	SortAddedAsc
+	// SortPriceAsc lists the cheapest item first
+	SortPriceAsc
+	// SortPriceDesc lists the most expensive item first
+	SortPriceDesc
+	// SortNameAsc lists the items in alphabetical order of name
+	SortNameAsc
+	// SortNameDesc lists the items in reverse alphabetical order of name
+	SortNameDesc
+)
+
+// FavouriteCursor is the position of a favourite in a list of favourites sorted by the time they were added.
+// Listing after a cursor is not affected by favourites added or removed since the cursor was taken.
+type FavouriteCursor struct {
+	TimeAdded time.Time
+	ID        int64
+}
+
+// Cursor returns the cursor positioned at the favourite.
+func (fav Favourite) Cursor() FavouriteCursor {
+	return FavouriteCursor{TimeAdded: fav.TimeAdded, ID: fav.ID}
+}
+
+// TagCount is the number of a user's favourites with a tag.
+type TagCount struct {
+	Tag   string
+	Count int
+}
+
+// AlertRule defines when a user is alerted about a price drop of a favourite.
+// The user is alerted when the price falls to TargetPrice, or by DropPercent percent from BasePrice.
+// A zero TargetPrice or DropPercent disables that condition.
+type AlertRule struct {
+	TargetPrice int64
+	DropPercent int32
+	// BasePrice is the item's price when the rule was set
+	BasePrice int64
+}
+
+// Enabled reports whether the rule has any condition set.
+func (r AlertRule) Enabled() bool {
+	return r.TargetPrice > 0 || r.DropPercent > 0
+}
+
+// Triggered reports whether the price meets any of the rule's conditions.
+func (r AlertRule) Triggered(price int64) bool {
+	if r.TargetPrice > 0 && price <= r.TargetPrice {
+		return true
+	}
+	return r.DropPercent > 0 && price <= r.BasePrice*int64(100-r.DropPercent)/100
+}
+
+// FavouritedItem is an item along with the number of users who have it in their favourites.
+type FavouritedItem struct {
+	ItemID int64
+	ShopID int64
+	Count  int
 }
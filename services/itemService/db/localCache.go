@@ -0,0 +1,103 @@
+package db
+
+import (
+	"container/list"
+	constants "itemService/constants"
+	metrics "itemService/metrics"
+	"sync"
+	"time"
+)
+
+// LocalCache is a bounded in-process LRU cache with a TTL on every entry. It is safe for concurrent use.
+// When the cache is full, the least recently used entry is evicted.
+type LocalCache struct {
+	mu     sync.Mutex
+	size   int
+	expire time.Duration
+	// lru holds the entries with the most recently used first
+	lru     *list.List
+	entries map[string]*list.Element
+	now     func() time.Time
+}
+
+type localEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLocalCache returns a LocalCache holding up to size entries, each for at most expire.
+func NewLocalCache(size int, expire time.Duration) *LocalCache {
+	return &LocalCache{
+		size:    size,
+		expire:  expire,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+		now:     time.Now,
+	}
+}
+
+// Get returns the value of the key, and whether the key was in the cache and has not expired.
+func (c *LocalCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*localEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(elem)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set adds or replaces the value of the key. The entry expires after exp or the cache's expiry, whichever is shorter.
+func (c *LocalCache) Set(key string, value []byte, exp time.Duration) {
+	if c.size <= 0 {
+		return
+	}
+	if exp <= 0 || exp > c.expire {
+		exp = c.expire
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(exp)
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*localEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&localEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+		metrics.CacheEvictions.WithLabelValues(constants.Local).Inc()
+	}
+}
+
+// Del removes the keys from the cache.
+func (c *LocalCache) Del(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if elem, ok := c.entries[key]; ok {
+			c.remove(elem)
+		}
+	}
+}
+
+// remove removes the entry from the cache.
+// The caller must hold the lock.
+func (c *LocalCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*localEntry).key)
+}
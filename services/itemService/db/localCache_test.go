@@ -0,0 +1,55 @@
+package db
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLocalCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLocalCache(2, time.Minute)
+	c.Set("a", []byte("1"), 0)
+	c.Set("b", []byte("2"), 0)
+	// a is now more recently used than b
+	c.Get("a")
+	c.Set("c", []byte("3"), 0)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("Get(b): got hit, want b evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("Get(%s): got miss, want hit", key)
+		}
+	}
+}
+
+func TestLocalCacheExpiry(t *testing.T) {
+	now := time.Now()
+	c := NewLocalCache(10, time.Minute)
+	c.now = func() time.Time { return now }
+	c.Set("a", []byte("1"), 0)
+	// shorter expiry than the cache's
+	c.Set("b", []byte("2"), time.Second)
+
+	now = now.Add(time.Second)
+	if _, ok := c.Get("a"); !ok {
+		t.Error("Get(a): got miss, want hit")
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Error("Get(b): got hit, want b expired")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Error("Get(a): got hit, want a expired")
+	}
+}
+
+func TestLocalCacheDel(t *testing.T) {
+	c := NewLocalCache(10, time.Minute)
+	c.Set("a", []byte("1"), 0)
+	c.Del("a")
+	if _, ok := c.Get("a"); ok {
+		t.Error("Get(a): got hit after Del")
+	}
+}
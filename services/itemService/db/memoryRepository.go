@@ -0,0 +1,580 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryRepository is an in-memory implementation of Repository, used for local development and tests.
+// It is safe for concurrent use.
+type MemoryRepository struct {
+	mu     sync.RWMutex
+	nextID int64
+	// favourites holds each user's favourites in the order they were added
+	favourites map[int64][]Favourite
+	// collections holds each user's collections in the order they were created
+	collections map[int64][]Collection
+	// prices holds each item's recorded prices in the order they were recorded
+	prices map[itemKey][]PricePoint
+}
+
+// itemKey identifies an item.
+type itemKey struct {
+	itemID int64
+	shopID int64
+}
+
+// NewMemoryRepository returns an empty MemoryRepository.
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		favourites:  make(map[int64][]Favourite),
+		collections: make(map[int64][]Collection),
+		prices:      make(map[itemKey][]PricePoint),
+	}
+}
+
+// Add appends the favourite to the user's favourites.
+func (r *MemoryRepository) Add(ctx context.Context, userID int64, itemID int64, shopID int64, item ItemSnapshot, rule AlertRule) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.add(userID, NewFavourite{ItemID: itemID, ShopID: shopID, Item: item, AlertRule: rule})
+}
+
+// BatchAdd appends the favourites to the user's favourites, holding the lock throughout so that the batch is applied at once.
+func (r *MemoryRepository) BatchAdd(ctx context.Context, userID int64, favourites []NewFavourite) ([]int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]int64, len(favourites))
+	for i, fav := range favourites {
+		// a duplicate favourite is left with an ID of 0
+		ids[i], _ = r.add(userID, fav)
+	}
+	return ids, nil
+}
+
+// add appends the favourite to the user's favourites. The caller must hold the write lock.
+func (r *MemoryRepository) add(userID int64, fav NewFavourite) (int64, error) {
+	if r.find(userID, fav.ItemID, fav.ShopID) != -1 {
+		return 0, ErrDuplicateFavourite
+	}
+
+	timeAdded := fav.TimeAdded
+	if timeAdded.IsZero() {
+		timeAdded = time.Now()
+	}
+	// copy the tags so that the caller's slice is not shared, sorted as SetNoteAndTags stores them
+	var tags []string
+	if len(fav.Tags) > 0 {
+		tags = append([]string(nil), fav.Tags...)
+		sort.Strings(tags)
+	}
+	r.nextID++
+	r.favourites[userID] = append(r.favourites[userID], Favourite{
+		ID:        r.nextID,
+		UserID:    userID,
+		ItemID:    fav.ItemID,
+		ShopID:    fav.ShopID,
+		TimeAdded: timeAdded,
+		Note:      fav.Note,
+		Tags:      tags,
+		Item:      fav.Item,
+		AlertRule: fav.AlertRule,
+	})
+	return r.nextID, nil
+}
+
+// Get returns a copy of the user's favourite.
+func (r *MemoryRepository) Get(ctx context.Context, userID int64, itemID int64, shopID int64) (*Favourite, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i := r.find(userID, itemID, shopID)
+	if i == -1 {
+		return nil, sql.ErrNoRows
+	}
+	fav := r.favourites[userID][i]
+	return &fav, nil
+}
+
+// Delete removes the favourite from the user's favourites.
+func (r *MemoryRepository) Delete(ctx context.Context, userID int64, itemID int64, shopID int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.remove(userID, itemID, shopID), nil
+}
+
+// BatchDelete removes the items from the user's favourites, holding the lock throughout so that the batch is applied at once.
+func (r *MemoryRepository) BatchDelete(ctx context.Context, userID int64, items []ItemKey) ([]bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := make([]bool, len(items))
+	for i, item := range items {
+		deleted[i] = r.remove(userID, item.ItemID, item.ShopID) > 0
+	}
+	return deleted, nil
+}
+
+// DeleteAll removes all of the user's favourites.
+func (r *MemoryRepository) DeleteAll(ctx context.Context, userID int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := int64(len(r.favourites[userID]))
+	delete(r.favourites, userID)
+	return deleted, nil
+}
+
+// remove removes the item from the user's favourites and returns the number of favourites removed. The caller must hold the write lock.
+func (r *MemoryRepository) remove(userID int64, itemID int64, shopID int64) int64 {
+	i := r.find(userID, itemID, shopID)
+	if i == -1 {
+		return 0
+	}
+	favourites := r.favourites[userID]
+	r.favourites[userID] = append(favourites[:i:i], favourites[i+1:]...)
+	return 1
+}
+
+// List returns a page of the user's favourites selected by the filter, in the sort order.
+func (r *MemoryRepository) List(ctx context.Context, userID int64, filter FavouriteFilter, sortBy FavouriteSort, limit int, offset int) ([]Favourite, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	favourites := append([]Favourite(nil), r.selected(userID, filter)...)
+	sort.SliceStable(favourites, func(i, j int) bool {
+		a, b := favourites[i], favourites[j]
+		switch sortBy {
+		case SortAddedAsc:
+			return addedBefore(a, b)
+		case SortPriceAsc:
+			return a.Item.Price < b.Item.Price || a.Item.Price == b.Item.Price && a.ID < b.ID
+		case SortPriceDesc:
+			return a.Item.Price > b.Item.Price || a.Item.Price == b.Item.Price && a.ID > b.ID
+		case SortNameAsc:
+			return a.Item.Name < b.Item.Name || a.Item.Name == b.Item.Name && a.ID < b.ID
+		case SortNameDesc:
+			return a.Item.Name > b.Item.Name || a.Item.Name == b.Item.Name && a.ID > b.ID
+		default:
+			return addedBefore(b, a)
+		}
+	})
+
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(favourites) {
+		return nil, nil
+	}
+	favourites = favourites[offset:]
+	if len(favourites) > limit {
+		favourites = favourites[:limit]
+	}
+	return favourites, nil
+}
+
+// ListAfter returns copies of the user's favourites selected by the filter after the cursor.
+func (r *MemoryRepository) ListAfter(ctx context.Context, userID int64, filter FavouriteFilter, sortBy FavouriteSort, after *FavouriteCursor, limit int) ([]Favourite, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// imported favourites keep their time added, so the favourites are not necessarily in order of time added
+	selected := append([]Favourite(nil), r.selected(userID, filter)...)
+	sort.Slice(selected, func(i, j int) bool {
+		return addedBefore(selected[i], selected[j])
+	})
+	var favourites []Favourite
+	for i := range selected {
+		fav := selected[len(selected)-1-i]
+		if sortBy == SortAddedAsc {
+			fav = selected[i]
+		}
+		if len(favourites) == limit {
+			break
+		}
+		if after != nil && !cursorPassed(fav.Cursor(), *after, sortBy == SortAddedAsc) {
+			continue
+		}
+		favourites = append(favourites, fav)
+	}
+	return favourites, nil
+}
+
+// Count returns the number of the user's favourites selected by the filter.
+func (r *MemoryRepository) Count(ctx context.Context, userID int64, filter FavouriteFilter) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.selected(userID, filter)), nil
+}
+
+// SetNoteAndTags replaces the note and tags of the user's favourite with the ID.
+func (r *MemoryRepository) SetNoteAndTags(ctx context.Context, id int64, userID int64, note string, tags []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	favourites := r.favourites[userID]
+	for i := range favourites {
+		if favourites[i].ID == id {
+			favourites[i].Note = note
+			// copy the tags so that the caller's slice is not shared
+			favourites[i].Tags = append([]string(nil), tags...)
+			sort.Strings(favourites[i].Tags)
+			return nil
+		}
+	}
+	return nil
+}
+
+// ListTags counts the user's favourites with each tag, and returns the counts with the most used tag first.
+func (r *MemoryRepository) ListTags(ctx context.Context, userID int64) ([]TagCount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make(map[string]int)
+	for _, fav := range r.favourites[userID] {
+		for _, tag := range fav.Tags {
+			counts[tag]++
+		}
+	}
+
+	tags := make([]TagCount, 0, len(counts))
+	for tag, count := range counts {
+		tags = append(tags, TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Count != tags[j].Count {
+			return tags[i].Count > tags[j].Count
+		}
+		return tags[i].Tag < tags[j].Tag
+	})
+	return tags, nil
+}
+
+// UpdateItemSnapshot sets the item's information in every user's favourite of the item.
+func (r *MemoryRepository) UpdateItemSnapshot(ctx context.Context, itemID int64, shopID int64, item ItemSnapshot) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, favourites := range r.favourites {
+		for i := range favourites {
+			if favourites[i].ItemID == itemID && favourites[i].ShopID == shopID {
+				favourites[i].Item = item
+			}
+		}
+	}
+	return nil
+}
+
+// MoveFavourite sets the collection of the user's favourite, if it is in the user's favourites.
+func (r *MemoryRepository) MoveFavourite(ctx context.Context, userID int64, itemID int64, shopID int64, collectionID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if i := r.find(userID, itemID, shopID); i != -1 {
+		r.favourites[userID][i].CollectionID = collectionID
+	}
+	return nil
+}
+
+// CreateCollection appends the collection to the user's collections.
+func (r *MemoryRepository) CreateCollection(ctx context.Context, userID int64, name string) (*Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, collection := range r.collections[userID] {
+		if collection.Name == name {
+			return nil, ErrDuplicateCollection
+		}
+	}
+
+	r.nextID++
+	collection := Collection{
+		ID:          r.nextID,
+		UserID:      userID,
+		Name:        name,
+		TimeCreated: time.Now(),
+	}
+	r.collections[userID] = append(r.collections[userID], collection)
+	return &collection, nil
+}
+
+// GetCollection returns a copy of the user's collection.
+func (r *MemoryRepository) GetCollection(ctx context.Context, userID int64, collectionID int64) (*Collection, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i := r.findCollection(userID, collectionID)
+	if i == -1 {
+		return nil, sql.ErrNoRows
+	}
+	collection := r.collections[userID][i]
+	return &collection, nil
+}
+
+// ListCollections returns copies of the user's collections with the number of favourites in each, the oldest first.
+func (r *MemoryRepository) ListCollections(ctx context.Context, userID int64) ([]Collection, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	collections := append([]Collection(nil), r.collections[userID]...)
+	for i := range collections {
+		collections[i].Count = len(r.selected(userID, FavouriteFilter{CollectionID: collections[i].ID}))
+	}
+	return collections, nil
+}
+
+// RenameCollection renames the user's collection, if the user has it.
+func (r *MemoryRepository) RenameCollection(ctx context.Context, userID int64, collectionID int64, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, collection := range r.collections[userID] {
+		if collection.Name == name && collection.ID != collectionID {
+			return ErrDuplicateCollection
+		}
+	}
+	if i := r.findCollection(userID, collectionID); i != -1 {
+		r.collections[userID][i].Name = name
+	}
+	return nil
+}
+
+// DeleteCollection removes the collection from the user's collections and moves its favourites out of any collection.
+func (r *MemoryRepository) DeleteCollection(ctx context.Context, userID int64, collectionID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := r.findCollection(userID, collectionID)
+	if i == -1 {
+		return nil
+	}
+	collections := r.collections[userID]
+	r.collections[userID] = append(collections[:i:i], collections[i+1:]...)
+
+	favourites := r.favourites[userID]
+	for j := range favourites {
+		if favourites[j].CollectionID == collectionID {
+			favourites[j].CollectionID = 0
+		}
+	}
+	return nil
+}
+
+// DeleteAllCollections deletes all of the user's collections.
+func (r *MemoryRepository) DeleteAllCollections(ctx context.Context, userID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.collections, userID)
+	return nil
+}
+
+// UpdateAlertRule replaces the alert rule of the user's favourite, if it is in the user's favourites.
+func (r *MemoryRepository) UpdateAlertRule(ctx context.Context, userID int64, itemID int64, shopID int64, rule AlertRule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if i := r.find(userID, itemID, shopID); i != -1 {
+		fav := &r.favourites[userID][i]
+		fav.AlertRule = rule
+		fav.AlertedPrice = 0
+	}
+	return nil
+}
+
+// ListAlerting returns copies of the item's favourites with an alert rule set, across all users.
+func (r *MemoryRepository) ListAlerting(ctx context.Context, itemID int64, shopID int64) ([]Favourite, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var alerting []Favourite
+	for _, favourites := range r.favourites {
+		for _, fav := range favourites {
+			if fav.ItemID == itemID && fav.ShopID == shopID && fav.Enabled() {
+				alerting = append(alerting, fav)
+			}
+		}
+	}
+	return alerting, nil
+}
+
+// SetAlertedPrice sets the alerted price of the favourite with the ID.
+func (r *MemoryRepository) SetAlertedPrice(ctx context.Context, id int64, price int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, favourites := range r.favourites {
+		for i := range favourites {
+			if favourites[i].ID == id {
+				favourites[i].AlertedPrice = price
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// ClaimAlert sets the alerted price of the favourite with the ID, if it is 0 or higher than the price.
+func (r *MemoryRepository) ClaimAlert(ctx context.Context, id int64, price int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, favourites := range r.favourites {
+		for i := range favourites {
+			if favourites[i].ID == id {
+				if favourites[i].AlertedPrice != 0 && favourites[i].AlertedPrice <= price {
+					return false, nil
+				}
+				favourites[i].AlertedPrice = price
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// TopFavourited counts the favourites of each item across all users and returns the items with the most favourites.
+func (r *MemoryRepository) TopFavourited(ctx context.Context, limit int) ([]FavouritedItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make(map[itemKey]int)
+	for _, favourites := range r.favourites {
+		for _, fav := range favourites {
+			counts[itemKey{fav.ItemID, fav.ShopID}]++
+		}
+	}
+
+	items := make([]FavouritedItem, 0, len(counts))
+	for key, count := range counts {
+		items = append(items, FavouritedItem{ItemID: key.itemID, ShopID: key.shopID, Count: count})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Count > items[j].Count
+	})
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
+
+// AddPricePoint appends the price to the item's recorded prices.
+func (r *MemoryRepository) AddPricePoint(ctx context.Context, itemID int64, shopID int64, price int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	key := itemKey{itemID, shopID}
+	r.prices[key] = append(r.prices[key], PricePoint{
+		ID:           r.nextID,
+		ItemID:       itemID,
+		ShopID:       shopID,
+		Price:        price,
+		TimeRecorded: time.Now(),
+	})
+	return nil
+}
+
+// LatestPricePoint returns a copy of the item's most recently recorded price.
+func (r *MemoryRepository) LatestPricePoint(ctx context.Context, itemID int64, shopID int64) (*PricePoint, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	prices := r.prices[itemKey{itemID, shopID}]
+	if len(prices) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	point := prices[len(prices)-1]
+	return &point, nil
+}
+
+// ListPricePoints returns the item's prices recorded between from and to inclusive, the oldest first.
+func (r *MemoryRepository) ListPricePoints(ctx context.Context, itemID int64, shopID int64, from time.Time, to time.Time) ([]PricePoint, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var points []PricePoint
+	for _, point := range r.prices[itemKey{itemID, shopID}] {
+		if !point.TimeRecorded.Before(from) && !point.TimeRecorded.After(to) {
+			points = append(points, point)
+		}
+	}
+	return points, nil
+}
+
+// selected returns the user's favourites selected by the filter, in the order they were added.
+// The caller must hold the lock.
+func (r *MemoryRepository) selected(userID int64, filter FavouriteFilter) []Favourite {
+	if filter == (FavouriteFilter{}) {
+		return r.favourites[userID]
+	}
+	var favourites []Favourite
+	for _, fav := range r.favourites[userID] {
+		if filter.CollectionID != 0 && fav.CollectionID != filter.CollectionID {
+			continue
+		}
+		if filter.Tag != "" && !hasTag(fav.Tags, filter.Tag) {
+			continue
+		}
+		if filter.ShopID != 0 && fav.ShopID != filter.ShopID {
+			continue
+		}
+		if filter.MinPrice != 0 && fav.Item.Price < filter.MinPrice || filter.MaxPrice != 0 && fav.Item.Price > filter.MaxPrice {
+			continue
+		}
+		favourites = append(favourites, fav)
+	}
+	return favourites
+}
+
+// cursorPassed reports whether the cursor c comes after the cursor after, in ascending or descending order.
+func cursorPassed(c FavouriteCursor, after FavouriteCursor, ascending bool) bool {
+	if !c.TimeAdded.Equal(after.TimeAdded) {
+		return c.TimeAdded.After(after.TimeAdded) == ascending
+	}
+	return c.ID != after.ID && c.ID > after.ID == ascending
+}
+
+// addedBefore reports whether a was added before b, by time added and then by ID.
+func addedBefore(a Favourite, b Favourite) bool {
+	return a.TimeAdded.Before(b.TimeAdded) || a.TimeAdded.Equal(b.TimeAdded) && a.ID < b.ID
+}
+
+// hasTag reports whether the tag is in tags.
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// findCollection returns the index of the collection in the user's collections, or -1 if it is not found.
+// The caller must hold the lock.
+func (r *MemoryRepository) findCollection(userID int64, collectionID int64) int {
+	for i, collection := range r.collections[userID] {
+		if collection.ID == collectionID {
+			return i
+		}
+	}
+	return -1
+}
+
+// find returns the index of the item in the user's favourites, or -1 if it is not found.
+// The caller must hold the lock.
+func (r *MemoryRepository) find(userID int64, itemID int64, shopID int64) int {
+	for i, fav := range r.favourites[userID] {
+		if fav.ItemID == itemID && fav.ShopID == shopID {
+			return i
+		}
+	}
+	return -1
+}
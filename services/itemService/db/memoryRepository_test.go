@@ -0,0 +1,402 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMemoryRepositoryAddGetDelete(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	id, err := repo.Add(ctx, 1, 100, 200, ItemSnapshot{}, AlertRule{})
+	if err != nil {
+		t.Fatalf("Add: unexpected error %v", err)
+	}
+
+	fav, err := repo.Get(ctx, 1, 100, 200)
+	if err != nil {
+		t.Fatalf("Get: unexpected error %v", err)
+	}
+	if fav.ID != id || fav.UserID != 1 || fav.ItemID != 100 || fav.ShopID != 200 {
+		t.Errorf("Get: got %+v", fav)
+	}
+
+	if _, err := repo.Add(ctx, 1, 100, 200, ItemSnapshot{}, AlertRule{}); err != ErrDuplicateFavourite {
+		t.Errorf("Add duplicate: got %v, want ErrDuplicateFavourite", err)
+	}
+
+	// another user can favourite the same item
+	if _, err := repo.Add(ctx, 2, 100, 200, ItemSnapshot{}, AlertRule{}); err != nil {
+		t.Errorf("Add for another user: unexpected error %v", err)
+	}
+
+	deleted, err := repo.Delete(ctx, 1, 100, 200)
+	if err != nil || deleted != 1 {
+		t.Errorf("Delete: got (%d, %v), want (1, nil)", deleted, err)
+	}
+	if _, err := repo.Get(ctx, 1, 100, 200); err != sql.ErrNoRows {
+		t.Errorf("Get after Delete: got %v, want sql.ErrNoRows", err)
+	}
+	deleted, err = repo.Delete(ctx, 1, 100, 200)
+	if err != nil || deleted != 0 {
+		t.Errorf("Delete missing favourite: got (%d, %v), want (0, nil)", deleted, err)
+	}
+}
+
+func TestMemoryRepositoryListAndCount(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	for itemID := int64(1); itemID <= 5; itemID++ {
+		if _, err := repo.Add(ctx, 1, itemID, 10, ItemSnapshot{}, AlertRule{}); err != nil {
+			t.Fatalf("Add: unexpected error %v", err)
+		}
+	}
+
+	count, err := repo.Count(ctx, 1, FavouriteFilter{})
+	if err != nil || count != 5 {
+		t.Errorf("Count: got (%d, %v), want (5, nil)", count, err)
+	}
+
+	tests := []struct {
+		limit, offset int
+		want          []int64
+	}{
+		{limit: 2, offset: 0, want: []int64{5, 4}},
+		{limit: 2, offset: 2, want: []int64{3, 2}},
+		{limit: 2, offset: 4, want: []int64{1}},
+		{limit: 2, offset: 6, want: nil},
+		{limit: 2, offset: -2, want: []int64{5, 4}},
+	}
+	for _, tt := range tests {
+		page, err := repo.List(ctx, 1, FavouriteFilter{}, SortAddedDesc, tt.limit, tt.offset)
+		if err != nil {
+			t.Fatalf("List(%d, %d): unexpected error %v", tt.limit, tt.offset, err)
+		}
+		if len(page) != len(tt.want) {
+			t.Fatalf("List(%d, %d): got %d favourites, want %d", tt.limit, tt.offset, len(page), len(tt.want))
+		}
+		for i, fav := range page {
+			if fav.ItemID != tt.want[i] {
+				t.Errorf("List(%d, %d)[%d]: got itemID %d, want %d", tt.limit, tt.offset, i, fav.ItemID, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestMemoryRepositoryConcurrentAdd(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	var wg sync.WaitGroup
+	for itemID := int64(1); itemID <= 50; itemID++ {
+		wg.Add(1)
+		go func(itemID int64) {
+			defer wg.Done()
+			repo.Add(ctx, 1, itemID, 10, ItemSnapshot{}, AlertRule{})
+			repo.Count(ctx, 1, FavouriteFilter{})
+		}(itemID)
+	}
+	wg.Wait()
+
+	count, _ := repo.Count(ctx, 1, FavouriteFilter{})
+	if count != 50 {
+		t.Errorf("Count: got %d, want 50", count)
+	}
+}
+
+func TestMemoryRepositoryCollections(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	deals, err := repo.CreateCollection(ctx, 1, "deals")
+	if err != nil {
+		t.Fatalf("CreateCollection: unexpected error %v", err)
+	}
+	if _, err := repo.CreateCollection(ctx, 1, "deals"); err != ErrDuplicateCollection {
+		t.Errorf("CreateCollection duplicate: got %v, want ErrDuplicateCollection", err)
+	}
+	gifts, _ := repo.CreateCollection(ctx, 1, "gifts")
+	if err := repo.RenameCollection(ctx, 1, gifts.ID, "deals"); err != ErrDuplicateCollection {
+		t.Errorf("RenameCollection to taken name: got %v, want ErrDuplicateCollection", err)
+	}
+
+	for itemID := int64(1); itemID <= 3; itemID++ {
+		repo.Add(ctx, 1, itemID, 10, ItemSnapshot{}, AlertRule{})
+	}
+	repo.MoveFavourite(ctx, 1, 1, 10, deals.ID)
+	repo.MoveFavourite(ctx, 1, 2, 10, deals.ID)
+
+	if count, _ := repo.Count(ctx, 1, FavouriteFilter{CollectionID: deals.ID}); count != 2 {
+		t.Errorf("Count in collection: got %d, want 2", count)
+	}
+	if count, _ := repo.Count(ctx, 1, FavouriteFilter{}); count != 3 {
+		t.Errorf("Count of all favourites: got %d, want 3", count)
+	}
+	page, _ := repo.List(ctx, 1, FavouriteFilter{CollectionID: deals.ID}, SortAddedDesc, 10, 0)
+	if len(page) != 2 || page[0].ItemID != 2 || page[1].ItemID != 1 {
+		t.Errorf("List in collection: got %+v, want items 2 and 1", page)
+	}
+	collections, _ := repo.ListCollections(ctx, 1)
+	if len(collections) != 2 || collections[0].Count != 2 || collections[1].Count != 0 {
+		t.Errorf("ListCollections: got %+v", collections)
+	}
+
+	// deleting a collection keeps its favourites
+	if err := repo.DeleteCollection(ctx, 1, deals.ID); err != nil {
+		t.Fatalf("DeleteCollection: unexpected error %v", err)
+	}
+	if _, err := repo.GetCollection(ctx, 1, deals.ID); err != sql.ErrNoRows {
+		t.Errorf("GetCollection after DeleteCollection: got %v, want sql.ErrNoRows", err)
+	}
+	if fav, _ := repo.Get(ctx, 1, 1, 10); fav.CollectionID != 0 {
+		t.Errorf("favourite in deleted collection: got collectionID %d, want 0", fav.CollectionID)
+	}
+}
+
+func TestMemoryRepositoryTags(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	ids := make([]int64, 3)
+	for i := range ids {
+		ids[i], _ = repo.Add(ctx, 1, int64(i+1), 10, ItemSnapshot{}, AlertRule{})
+	}
+	repo.SetNoteAndTags(ctx, ids[0], 1, "for mum", []string{"gift", "sale"})
+	repo.SetNoteAndTags(ctx, ids[1], 1, "", []string{"sale"})
+	// replacing the tags drops the old ones
+	repo.SetNoteAndTags(ctx, ids[2], 1, "", []string{"gift"})
+	repo.SetNoteAndTags(ctx, ids[2], 1, "", []string{"sale"})
+
+	fav, _ := repo.Get(ctx, 1, 1, 10)
+	if fav.Note != "for mum" || len(fav.Tags) != 2 {
+		t.Errorf("Get: got note %q and tags %v", fav.Note, fav.Tags)
+	}
+
+	filter := FavouriteFilter{Tag: "sale"}
+	if count, _ := repo.Count(ctx, 1, filter); count != 3 {
+		t.Errorf("Count with tag: got %d, want 3", count)
+	}
+	page, _ := repo.List(ctx, 1, FavouriteFilter{Tag: "gift"}, SortAddedDesc, 10, 0)
+	if len(page) != 1 || page[0].ID != ids[0] {
+		t.Errorf("List with tag: got %+v, want only favourite %d", page, ids[0])
+	}
+
+	tags, _ := repo.ListTags(ctx, 1)
+	want := []TagCount{{Tag: "sale", Count: 3}, {Tag: "gift", Count: 1}}
+	if len(tags) != len(want) || tags[0] != want[0] || tags[1] != want[1] {
+		t.Errorf("ListTags: got %+v, want %+v", tags, want)
+	}
+}
+
+func TestMemoryRepositorySortAndFilter(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	repo.Add(ctx, 1, 1, 10, ItemSnapshot{Name: "b", Price: 300}, AlertRule{})
+	repo.Add(ctx, 1, 2, 20, ItemSnapshot{Name: "a", Price: 100}, AlertRule{})
+	repo.Add(ctx, 1, 3, 10, ItemSnapshot{Name: "c", Price: 100}, AlertRule{})
+	// a later fetch updates the snapshot of every favourite of the item
+	repo.UpdateItemSnapshot(ctx, 1, 10, ItemSnapshot{Name: "b", Price: 200})
+
+	tests := []struct {
+		name   string
+		filter FavouriteFilter
+		sort   FavouriteSort
+		want   []int64
+	}{
+		{"added desc", FavouriteFilter{}, SortAddedDesc, []int64{3, 2, 1}},
+		{"added asc", FavouriteFilter{}, SortAddedAsc, []int64{1, 2, 3}},
+		// equal prices are ordered by ID
+		{"price asc", FavouriteFilter{}, SortPriceAsc, []int64{2, 3, 1}},
+		{"price desc", FavouriteFilter{}, SortPriceDesc, []int64{1, 3, 2}},
+		{"name asc", FavouriteFilter{}, SortNameAsc, []int64{2, 1, 3}},
+		{"shop", FavouriteFilter{ShopID: 10}, SortAddedDesc, []int64{3, 1}},
+		{"price range", FavouriteFilter{MinPrice: 150, MaxPrice: 250}, SortAddedDesc, []int64{1}},
+	}
+	for _, tt := range tests {
+		page, err := repo.List(ctx, 1, tt.filter, tt.sort, 10, 0)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", tt.name, err)
+		}
+		var got []int64
+		for _, fav := range page {
+			got = append(got, fav.ItemID)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got items %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: got items %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestMemoryRepositoryListAfter(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	for itemID := int64(1); itemID <= 5; itemID++ {
+		if _, err := repo.Add(ctx, 1, itemID, 10, ItemSnapshot{}, AlertRule{}); err != nil {
+			t.Fatalf("Add: unexpected error %v", err)
+		}
+	}
+
+	first, err := repo.ListAfter(ctx, 1, FavouriteFilter{}, SortAddedDesc, nil, 2)
+	if err != nil || len(first) != 2 || first[0].ItemID != 5 || first[1].ItemID != 4 {
+		t.Fatalf("ListAfter first page: got (%+v, %v)", first, err)
+	}
+
+	// a favourite added mid-scroll does not shift the next page
+	if _, err := repo.Add(ctx, 1, 6, 10, ItemSnapshot{}, AlertRule{}); err != nil {
+		t.Fatalf("Add: unexpected error %v", err)
+	}
+	cursor := first[1].Cursor()
+	next, err := repo.ListAfter(ctx, 1, FavouriteFilter{}, SortAddedDesc, &cursor, 5)
+	if err != nil || len(next) != 3 || next[0].ItemID != 3 || next[2].ItemID != 1 {
+		t.Errorf("ListAfter next page: got (%+v, %v)", next, err)
+	}
+
+	cursor = next[0].Cursor()
+	asc, err := repo.ListAfter(ctx, 1, FavouriteFilter{}, SortAddedAsc, &cursor, 5)
+	if err != nil || len(asc) != 3 || asc[0].ItemID != 4 || asc[2].ItemID != 6 {
+		t.Errorf("ListAfter ascending: got (%+v, %v)", asc, err)
+	}
+}
+
+func TestMemoryRepositoryBatch(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	if _, err := repo.Add(ctx, 1, 100, 10, ItemSnapshot{}, AlertRule{}); err != nil {
+		t.Fatalf("Add: unexpected error %v", err)
+	}
+
+	ids, err := repo.BatchAdd(ctx, 1, []NewFavourite{
+		{ItemID: 100, ShopID: 10},
+		{ItemID: 101, ShopID: 10, AlertRule: AlertRule{TargetPrice: 50}},
+		{ItemID: 101, ShopID: 10},
+	})
+	if err != nil || len(ids) != 3 || ids[0] != 0 || ids[1] == 0 || ids[2] != 0 {
+		t.Fatalf("BatchAdd: got (%v, %v), want the duplicates to have no ID", ids, err)
+	}
+	if fav, err := repo.Get(ctx, 1, 101, 10); err != nil || fav.ID != ids[1] || fav.TargetPrice != 50 {
+		t.Errorf("Get after BatchAdd: got (%+v, %v)", fav, err)
+	}
+
+	// the tags are copied and sorted, as SetNoteAndTags stores them
+	tags := []string{"sale", "gift"}
+	if _, err := repo.BatchAdd(ctx, 1, []NewFavourite{{ItemID: 102, ShopID: 10, Tags: tags}}); err != nil {
+		t.Fatalf("BatchAdd with tags: unexpected error %v", err)
+	}
+	tags[0] = "changed"
+	if fav, _ := repo.Get(ctx, 1, 102, 10); len(fav.Tags) != 2 || fav.Tags[0] != "gift" || fav.Tags[1] != "sale" {
+		t.Errorf("Get after BatchAdd with tags: got tags %v, want [gift sale]", fav.Tags)
+	}
+	repo.Delete(ctx, 1, 102, 10)
+
+	deleted, err := repo.BatchDelete(ctx, 1, []ItemKey{{ItemID: 100, ShopID: 10}, {ItemID: 102, ShopID: 10}, {ItemID: 101, ShopID: 10}})
+	if err != nil || len(deleted) != 3 || !deleted[0] || deleted[1] || !deleted[2] {
+		t.Errorf("BatchDelete: got (%v, %v)", deleted, err)
+	}
+	if count, _ := repo.Count(ctx, 1, FavouriteFilter{}); count != 0 {
+		t.Errorf("Count after BatchDelete: got %d, want 0", count)
+	}
+}
+
+func TestMemoryRepositoryDeleteAll(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	repo.Add(ctx, 1, 100, 10, ItemSnapshot{}, AlertRule{})
+	repo.Add(ctx, 1, 101, 10, ItemSnapshot{}, AlertRule{})
+	repo.Add(ctx, 2, 100, 10, ItemSnapshot{}, AlertRule{})
+	if _, err := repo.CreateCollection(ctx, 1, "wishlist"); err != nil {
+		t.Fatalf("CreateCollection: unexpected error %v", err)
+	}
+
+	if deleted, err := repo.DeleteAll(ctx, 1); err != nil || deleted != 2 {
+		t.Errorf("DeleteAll: got (%d, %v), want (2, nil)", deleted, err)
+	}
+	if err := repo.DeleteAllCollections(ctx, 1); err != nil {
+		t.Errorf("DeleteAllCollections: unexpected error %v", err)
+	}
+	if collections, _ := repo.ListCollections(ctx, 1); len(collections) != 0 {
+		t.Errorf("ListCollections after DeleteAllCollections: got %d collections, want 0", len(collections))
+	}
+	// deleting again succeeds, and other users' favourites are kept
+	if deleted, err := repo.DeleteAll(ctx, 1); err != nil || deleted != 0 {
+		t.Errorf("DeleteAll again: got (%d, %v), want (0, nil)", deleted, err)
+	}
+	if count, _ := repo.Count(ctx, 2, FavouriteFilter{}); count != 1 {
+		t.Errorf("Count of other user: got %d, want 1", count)
+	}
+}
+
+func TestMemoryRepositoryTopFavourited(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	// item 100 is favourited by three users, item 101 by two and item 102 by one
+	for userID := int64(1); userID <= 3; userID++ {
+		repo.Add(ctx, userID, 100, 200, ItemSnapshot{}, AlertRule{})
+	}
+	for userID := int64(1); userID <= 2; userID++ {
+		repo.Add(ctx, userID, 101, 200, ItemSnapshot{}, AlertRule{})
+	}
+	repo.Add(ctx, 1, 102, 200, ItemSnapshot{}, AlertRule{})
+
+	items, err := repo.TopFavourited(ctx, 2)
+	if err != nil {
+		t.Fatalf("TopFavourited: unexpected error %v", err)
+	}
+	want := []FavouritedItem{{ItemID: 100, ShopID: 200, Count: 3}, {ItemID: 101, ShopID: 200, Count: 2}}
+	if len(items) != len(want) {
+		t.Fatalf("TopFavourited: got %+v, want %+v", items, want)
+	}
+	for i := range want {
+		if items[i] != want[i] {
+			t.Errorf("TopFavourited[%d]: got %+v, want %+v", i, items[i], want[i])
+		}
+	}
+}
+
+func TestMemoryRepositoryPricePoints(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	if _, err := repo.LatestPricePoint(ctx, 100, 200); err != sql.ErrNoRows {
+		t.Errorf("LatestPricePoint with no prices: got %v, want sql.ErrNoRows", err)
+	}
+
+	from := time.Now()
+	repo.AddPricePoint(ctx, 100, 200, 1000)
+	repo.AddPricePoint(ctx, 100, 200, 900)
+	repo.AddPricePoint(ctx, 101, 200, 500)
+
+	latest, err := repo.LatestPricePoint(ctx, 100, 200)
+	if err != nil || latest.Price != 900 {
+		t.Errorf("LatestPricePoint: got (%+v, %v), want price 900", latest, err)
+	}
+
+	points, err := repo.ListPricePoints(ctx, 100, 200, from, time.Now())
+	if err != nil {
+		t.Fatalf("ListPricePoints: unexpected error %v", err)
+	}
+	if len(points) != 2 || points[0].Price != 1000 || points[1].Price != 900 {
+		t.Errorf("ListPricePoints: got %+v, want prices 1000 then 900", points)
+	}
+
+	points, _ = repo.ListPricePoints(ctx, 100, 200, time.Now().Add(time.Hour), time.Now().Add(2*time.Hour))
+	if len(points) != 0 {
+		t.Errorf("ListPricePoints outside the range: got %+v, want none", points)
+	}
+}
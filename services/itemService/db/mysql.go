@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"itemService/tracing"
+	"sync"
 
 	"itemService/config"
 	"itemService/constants"
@@ -17,17 +18,32 @@ import (
 )
 
 const (
-	insertRow = "db.InsertRow"
-	queryOne  = "db.QueryOne"
-	queryRows = "db.QueryRows"
-	deleteOne = "db.DeleteOne"
+	insertRow  = "db.InsertRow"
+	queryOne   = "db.QueryOne"
+	queryRows  = "db.QueryRows"
+	deleteOne  = "db.DeleteOne"
+	updateRows = "db.UpdateRows"
+	inTx       = "db.InTx"
 )
 
-// DatabaseManager is a database manager struct containing a reference to the database connection, zap logger, and the database config
+// txKey is the context key of the transaction that statements run in.
+type txKey struct{}
+
+// DatabaseManager is a database manager struct containing a reference to the database connection, zap logger, and the database config.
+// Statements are prepared on first use and cached, keyed by their opName and query.
 type DatabaseManager struct {
-	db     *sql.DB
-	config *config.DbConfig
-	logger *zap.Logger
+	db      *sql.DB
+	config  *config.DbConfig
+	logger  *zap.Logger
+	stmtsMu sync.RWMutex
+	stmts   map[stmtKey]*sql.Stmt
+}
+
+// stmtKey identifies a cached prepared statement.
+// The query is part of the key as the same opName can be shared by different queries.
+type stmtKey struct {
+	opName string
+	query  string
 }
 
 // InitDatabase opens the database connection. It returns an error if the database fails to respond when pinged.
@@ -68,13 +84,14 @@ func InitDatabase(dbConfig *config.DbConfig, logger *zap.Logger) (*DatabaseManag
 		db:     db,
 		config: dbConfig,
 		logger: logger,
+		stmts:  make(map[stmtKey]*sql.Stmt),
 	}
 
 	return &dbManager, err
 }
 
-// QueryOne will query for a single *sql.Row, and write its contents into destination.
-func (dm *DatabaseManager) QueryOne(ctx context.Context, query string, opName string, destination ...any) error {
+// QueryOne will query for a single *sql.Row using the given placeholder arguments, and write its contents into destination.
+func (dm *DatabaseManager) QueryOne(ctx context.Context, query string, opName string, args []any, destination ...any) error {
 	// start tracing span from context
 	span, ctx := ot.StartSpanFromContext(ctx, queryOne)
 	dm.addSpanTags(span, query)
@@ -89,12 +106,19 @@ func (dm *DatabaseManager) QueryOne(ctx context.Context, query string, opName st
 		timer.ObserveDuration()
 	}()
 
-	res := dm.db.QueryRowContext(ctx, query)
-	err := res.Scan(destination...)
+	stmt, err := dm.prepare(ctx, query, opName)
+	if err != nil {
+		successStr = constants.False
+		return err
+	}
+
+	res := stmt.QueryRowContext(ctx, args...)
+	err = res.Scan(destination...)
 	if err != nil {
 		dm.logger.Error(
 			constants.ErrorDatabaseQueryMsg,
 			zap.String(constants.Query, query),
+			zap.Any(constants.Args, args),
 			zap.String(constants.OpName, opName),
 			zap.Error(err),
 		)
@@ -107,12 +131,13 @@ func (dm *DatabaseManager) QueryOne(ctx context.Context, query string, opName st
 	dm.logger.Info(
 		constants.InfoDatabaseQuery,
 		zap.String(constants.Query, query),
+		zap.Any(constants.Args, args),
 	)
 	return err
 }
 
-// QueryRows executes the given query and returns the queried rows.
-func (dm *DatabaseManager) QueryRows(ctx context.Context, query string, opName string) (*sql.Rows, error) {
+// QueryRows executes the given query with its placeholder arguments and returns the queried rows.
+func (dm *DatabaseManager) QueryRows(ctx context.Context, query string, opName string, args ...any) (*sql.Rows, error) {
 	// start tracing span from context
 	span, ctx := ot.StartSpanFromContext(ctx, queryRows)
 	dm.addSpanTags(span, query)
@@ -126,17 +151,27 @@ func (dm *DatabaseManager) QueryRows(ctx context.Context, query string, opName s
 		// observe duration at the end of this function
 		timer.ObserveDuration()
 	}()
-	rows, err := dm.db.QueryContext(ctx, query)
+	stmt, err := dm.prepare(ctx, query, opName)
+	if err != nil {
+		successStr = constants.False
+		return nil, err
+	}
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		successStr = constants.False
+	}
 	dm.logger.Info(
 		constants.InfoDatabaseQueryRows,
 		zap.String(constants.Query, query),
+		zap.Any(constants.Args, args),
 		zap.Error(err),
 	)
 	return rows, err
 }
 
-// InsertRow will insert a single row and return its ID.
-func (dm *DatabaseManager) InsertRow(ctx context.Context, query string, opName string) (int64, error) {
+// InsertRow will insert a single row using the given placeholder arguments and return its ID.
+func (dm *DatabaseManager) InsertRow(ctx context.Context, query string, opName string, args ...any) (int64, error) {
 	// start tracing span from context
 	span, ctx := ot.StartSpanFromContext(ctx, insertRow)
 	dm.addSpanTags(span, query)
@@ -151,12 +186,19 @@ func (dm *DatabaseManager) InsertRow(ctx context.Context, query string, opName s
 		timer.ObserveDuration()
 	}()
 
-	res, err := dm.db.ExecContext(ctx, query)
+	stmt, err := dm.prepare(ctx, query, opName)
+	if err != nil {
+		successStr = constants.False
+		return 0, err
+	}
+
+	res, err := stmt.ExecContext(ctx, args...)
 
 	if err != nil {
 		dm.logger.Error(
 			constants.ErrorDatabaseInsertMsg,
 			zap.String(constants.Query, query),
+			zap.Any(constants.Args, args),
 			zap.String(constants.OpName, opName),
 			zap.Error(err),
 		)
@@ -173,14 +215,15 @@ func (dm *DatabaseManager) InsertRow(ctx context.Context, query string, opName s
 	dm.logger.Info(
 		constants.InfoDatabaseInsert,
 		zap.String(constants.Query, query),
+		zap.Any(constants.Args, args),
 		zap.Any(constants.ID, id),
 	)
 
 	return id, err
 }
 
-// DeleteOne deletes a row from the database and returns the number of rows deleted
-func (dm *DatabaseManager) DeleteOne(ctx context.Context, query string, opName string) (int64, error) {
+// DeleteOne deletes a row from the database using the given placeholder arguments and returns the number of rows deleted
+func (dm *DatabaseManager) DeleteOne(ctx context.Context, query string, opName string, args ...any) (int64, error) {
 	// start tracing span from context
 	span, ctx := ot.StartSpanFromContext(ctx, deleteOne)
 	dm.addSpanTags(span, query)
@@ -195,10 +238,17 @@ func (dm *DatabaseManager) DeleteOne(ctx context.Context, query string, opName s
 		timer.ObserveDuration()
 	}()
 
-	res, err := dm.db.ExecContext(ctx, query)
+	stmt, err := dm.prepare(ctx, query, opName)
+	if err != nil {
+		successStr = constants.False
+		return 0, err
+	}
+
+	res, err := stmt.ExecContext(ctx, args...)
 	dm.logger.Info(
 		constants.InfoDatabaseDelete,
 		zap.String(constants.Query, query),
+		zap.Any(constants.Args, args),
 		zap.Any(constants.Res, res),
 	)
 
@@ -206,6 +256,7 @@ func (dm *DatabaseManager) DeleteOne(ctx context.Context, query string, opName s
 		dm.logger.Error(
 			constants.ErrorDatabaseDeleteMsg,
 			zap.String(constants.Query, query),
+			zap.Any(constants.Args, args),
 			zap.String(constants.OpName, opName),
 			zap.Error(err),
 		)
@@ -216,6 +267,160 @@ func (dm *DatabaseManager) DeleteOne(ctx context.Context, query string, opName s
 	return res.RowsAffected()
 }
 
+// UpdateRows updates rows in the database using the given placeholder arguments and returns the number of rows updated
+func (dm *DatabaseManager) UpdateRows(ctx context.Context, query string, opName string, args ...any) (int64, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, updateRows)
+	dm.addSpanTags(span, query)
+	defer span.Finish()
+	successStr := constants.True
+	// time database query
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.DatabaseOpDuration.WithLabelValues(dm.config.ServiceLabel, constants.Update, opName, successStr).Observe(v)
+	}))
+	defer func() {
+		// observe duration at the end of this function
+		timer.ObserveDuration()
+	}()
+
+	stmt, err := dm.prepare(ctx, query, opName)
+	if err != nil {
+		successStr = constants.False
+		return 0, err
+	}
+
+	res, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		dm.logger.Error(
+			constants.ErrorDatabaseUpdateMsg,
+			zap.String(constants.Query, query),
+			zap.Any(constants.Args, args),
+			zap.String(constants.OpName, opName),
+			zap.Error(err),
+		)
+		successStr = constants.False
+		return 0, err
+	}
+
+	dm.logger.Info(
+		constants.InfoDatabaseUpdate,
+		zap.String(constants.Query, query),
+		zap.Any(constants.Args, args),
+	)
+
+	return res.RowsAffected()
+}
+
+// InTx runs fn in a transaction. Statements run by the database manager with the context passed to fn are part of the transaction.
+// The transaction is committed if fn returns nil, and rolled back otherwise.
+// If the context already carries a transaction, fn runs as part of it.
+func (dm *DatabaseManager) InTx(ctx context.Context, opName string, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, inTx)
+	span.SetTag(tracing.DatabaseType, tracing.DatabaseTypeSQL)
+	span.SetTag(tracing.DatabaseInstance, dm.config.DbName)
+	span.SetTag(tracing.Component, tracing.ComponentMySQL)
+	defer span.Finish()
+	successStr := constants.True
+	// time the transaction
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.DatabaseOpDuration.WithLabelValues(dm.config.ServiceLabel, constants.Transaction, opName, successStr).Observe(v)
+	}))
+	defer func() {
+		// observe duration at the end of this function
+		timer.ObserveDuration()
+	}()
+
+	tx, err := dm.db.BeginTx(ctx, nil)
+	if err != nil {
+		dm.logger.Error(
+			constants.ErrorDatabaseTransactionMsg,
+			zap.String(constants.OpName, opName),
+			zap.Error(err),
+		)
+		successStr = constants.False
+		return err
+	}
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+	if err != nil {
+		successStr = constants.False
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			dm.logger.Error(
+				constants.ErrorDatabaseTransactionMsg,
+				zap.String(constants.OpName, opName),
+				zap.Error(rollbackErr),
+			)
+		}
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		dm.logger.Error(
+			constants.ErrorDatabaseTransactionMsg,
+			zap.String(constants.OpName, opName),
+			zap.Error(err),
+		)
+		successStr = constants.False
+	}
+	return err
+}
+
+// prepare returns the prepared statement for the query and opName.
+// If the context carries a transaction started by InTx, the statement runs in the transaction.
+func (dm *DatabaseManager) prepare(ctx context.Context, query string, opName string) (*sql.Stmt, error) {
+	stmt, err := dm.prepareCached(ctx, query, opName)
+	if err != nil {
+		return nil, err
+	}
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		// the transaction's statement is closed when the transaction ends
+		return tx.StmtContext(ctx, stmt), nil
+	}
+	return stmt, nil
+}
+
+// prepareCached returns the cached prepared statement for the query and opName, preparing and caching it on first use.
+// Concurrent first uses may each prepare the statement, and all but the cached one are closed.
+func (dm *DatabaseManager) prepareCached(ctx context.Context, query string, opName string) (*sql.Stmt, error) {
+	key := stmtKey{opName: opName, query: query}
+
+	dm.stmtsMu.RLock()
+	stmt, ok := dm.stmts[key]
+	dm.stmtsMu.RUnlock()
+	if ok {
+		return stmt, nil
+	}
+
+	// prepare outside the lock, so that a first use does not hold up every other query for a round trip
+	stmt, err := dm.db.PrepareContext(ctx, query)
+	if err != nil {
+		dm.logger.Error(
+			constants.ErrorDatabasePrepareMsg,
+			zap.String(constants.Query, query),
+			zap.String(constants.OpName, opName),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	dm.stmtsMu.Lock()
+	defer dm.stmtsMu.Unlock()
+	// another goroutine may have prepared the same statement meanwhile, keep the cached one
+	if cached, ok := dm.stmts[key]; ok {
+		stmt.Close()
+		return cached, nil
+	}
+	dm.stmts[key] = stmt
+
+	return stmt, nil
+}
+
 func (dm *DatabaseManager) addSpanTags(span ot.Span, statement string) {
 	span.SetTag(tracing.DatabaseType, tracing.DatabaseTypeSQL)
 	span.SetTag(tracing.DatabaseInstance, dm.config.DbName)
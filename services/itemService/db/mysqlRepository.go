@@ -0,0 +1,540 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"itemService/constants"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// mysqlErrDuplicateEntry is the MySQL error number for a violated unique key.
+const mysqlErrDuplicateEntry = 1062
+
+// favouriteColumns are the columns of the Favourites table, in the order scanned by Favourite.fields.
+const favouriteColumns = "id, userID, itemID, shopID, timeAdded, collectionID, note, itemName, itemPrice, targetPrice, dropPercent, basePrice, alertedPrice"
+
+// MySQLRepository implements Repository with the MySQL database, following the schema in schema/mysql.sql
+type MySQLRepository struct {
+	dbManager *DatabaseManager
+}
+
+// NewMySQLRepository returns a MySQLRepository that runs its queries through the given database manager.
+func NewMySQLRepository(dbManager *DatabaseManager) *MySQLRepository {
+	return &MySQLRepository{
+		dbManager: dbManager,
+	}
+}
+
+// Add inserts the favourite into the Favourites table.
+func (r *MySQLRepository) Add(ctx context.Context, userID int64, itemID int64, shopID int64, item ItemSnapshot, rule AlertRule) (int64, error) {
+	return r.add(ctx, userID, NewFavourite{ItemID: itemID, ShopID: shopID, Item: item, AlertRule: rule})
+}
+
+// add inserts the favourite into the Favourites table, with the current time if its time added is zero.
+func (r *MySQLRepository) add(ctx context.Context, userID int64, fav NewFavourite) (int64, error) {
+	var timeAdded any
+	if !fav.TimeAdded.IsZero() {
+		timeAdded = fav.TimeAdded
+	}
+	query := "INSERT INTO Favourites(userID, itemID, shopID, timeAdded, itemName, itemPrice, targetPrice, dropPercent, basePrice) VALUES(?, ?, ?, COALESCE(?, CURRENT_TIMESTAMP), ?, ?, ?, ?, ?)"
+	id, err := r.dbManager.InsertRow(ctx, query, constants.AddFav, userID, fav.ItemID, fav.ShopID, timeAdded, fav.Item.Name, fav.Item.Price, fav.TargetPrice, fav.DropPercent, fav.BasePrice)
+	if isDuplicateEntry(err) {
+		return 0, ErrDuplicateFavourite
+	}
+	return id, err
+}
+
+// BatchAdd inserts the favourites into the Favourites table, and their tags into the FavouriteTags table, in a transaction.
+// A duplicate favourite only fails its own statement, so the rest of the transaction carries on.
+func (r *MySQLRepository) BatchAdd(ctx context.Context, userID int64, favourites []NewFavourite) ([]int64, error) {
+	ids := make([]int64, len(favourites))
+	err := r.dbManager.InTx(ctx, constants.BatchAddFav, func(ctx context.Context) error {
+		for i, fav := range favourites {
+			id, err := r.add(ctx, userID, fav)
+			if err == ErrDuplicateFavourite {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			ids[i] = id
+			if fav.Note != "" || len(fav.Tags) > 0 {
+				err = r.SetNoteAndTags(ctx, id, userID, fav.Note, fav.Tags)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Get queries the Favourites table for the user's favourited item.
+func (r *MySQLRepository) Get(ctx context.Context, userID int64, itemID int64, shopID int64) (*Favourite, error) {
+	var fav Favourite
+	query := "SELECT " + favouriteColumns + " FROM Favourites WHERE userID=? AND itemID=? AND shopID=?"
+	err := r.dbManager.QueryOne(ctx, query, constants.GetFavList, []any{userID, itemID, shopID}, fav.fields()...)
+	if err != nil {
+		return nil, err
+	}
+	favourites := []Favourite{fav}
+	err = r.loadTags(ctx, favourites)
+	return &favourites[0], err
+}
+
+// Delete deletes the user's favourited item from the Favourites table.
+func (r *MySQLRepository) Delete(ctx context.Context, userID int64, itemID int64, shopID int64) (int64, error) {
+	query := "DELETE FROM Favourites WHERE userID=? and itemid=? and shopID=?"
+	return r.dbManager.DeleteOne(ctx, query, constants.DeleteFav, userID, itemID, shopID)
+}
+
+// DeleteAll deletes all of the user's favourited items from the Favourites table. Their tags are deleted by the foreign key.
+func (r *MySQLRepository) DeleteAll(ctx context.Context, userID int64) (int64, error) {
+	query := "DELETE FROM Favourites WHERE userID=?"
+	return r.dbManager.DeleteOne(ctx, query, constants.DeleteAllFav, userID)
+}
+
+// BatchDelete deletes the user's favourited items from the Favourites table in a transaction.
+func (r *MySQLRepository) BatchDelete(ctx context.Context, userID int64, items []ItemKey) ([]bool, error) {
+	deleted := make([]bool, len(items))
+	err := r.dbManager.InTx(ctx, constants.BatchDeleteFav, func(ctx context.Context) error {
+		for i, item := range items {
+			rows, err := r.Delete(ctx, userID, item.ItemID, item.ShopID)
+			if err != nil {
+				return err
+			}
+			deleted[i] = rows > 0
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// List queries a page of the user's favourites selected by the filter in the sort order, and their tags.
+func (r *MySQLRepository) List(ctx context.Context, userID int64, filter FavouriteFilter, sort FavouriteSort, limit int, offset int) ([]Favourite, error) {
+	where, args := filterClause(userID, filter)
+	query := "SELECT " + favouriteColumns + " FROM Favourites WHERE " + where + " ORDER BY " + orderClause(sort) + " LIMIT ? OFFSET ?"
+	args = append(args, limit, offset)
+
+	// query rows
+	rows, err := r.dbManager.QueryRows(ctx, query, constants.GetFavList, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var favourites []Favourite
+	// Loop through rows, using Scan to assign column data to struct fields.
+	for rows.Next() {
+		var fav Favourite
+		err := rows.Scan(fav.fields()...)
+		if err != nil {
+			return favourites, err
+		}
+		favourites = append(favourites, fav)
+	}
+	if err := rows.Err(); err != nil {
+		return favourites, err
+	}
+	return favourites, r.loadTags(ctx, favourites)
+}
+
+// ListAfter queries the Favourites table for the user's rows after the cursor, seeking on the index on (userID, timeAdded, id).
+func (r *MySQLRepository) ListAfter(ctx context.Context, userID int64, filter FavouriteFilter, sort FavouriteSort, after *FavouriteCursor, limit int) ([]Favourite, error) {
+	where, args := filterClause(userID, filter)
+	if after != nil {
+		op := "<"
+		if sort == SortAddedAsc {
+			op = ">"
+		}
+		where += " AND (timeAdded" + op + "? OR timeAdded=? AND id" + op + "?)"
+		args = append(args, after.TimeAdded, after.TimeAdded, after.ID)
+	}
+	query := "SELECT " + favouriteColumns + " FROM Favourites WHERE " + where + " ORDER BY " + orderClause(sort) + " LIMIT ?"
+	args = append(args, limit)
+
+	// query rows
+	rows, err := r.dbManager.QueryRows(ctx, query, constants.GetFavList, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var favourites []Favourite
+	for rows.Next() {
+		var fav Favourite
+		err := rows.Scan(fav.fields()...)
+		if err != nil {
+			return favourites, err
+		}
+		favourites = append(favourites, fav)
+	}
+	if err := rows.Err(); err != nil {
+		return favourites, err
+	}
+	return favourites, r.loadTags(ctx, favourites)
+}
+
+// UpdateAlertRule updates the alert columns of the user's row in the Favourites table.
+func (r *MySQLRepository) UpdateAlertRule(ctx context.Context, userID int64, itemID int64, shopID int64, rule AlertRule) error {
+	query := "UPDATE Favourites SET targetPrice=?, dropPercent=?, basePrice=?, alertedPrice=0 WHERE userID=? AND itemID=? AND shopID=?"
+	_, err := r.dbManager.UpdateRows(ctx, query, constants.UpdateFav, rule.TargetPrice, rule.DropPercent, rule.BasePrice, userID, itemID, shopID)
+	return err
+}
+
+// ListAlerting queries the Favourites table for the item's rows with an alert rule set.
+func (r *MySQLRepository) ListAlerting(ctx context.Context, itemID int64, shopID int64) ([]Favourite, error) {
+	query := "SELECT " + favouriteColumns + " FROM Favourites WHERE itemID=? AND shopID=? AND (targetPrice > 0 OR dropPercent > 0)"
+
+	// query rows
+	rows, err := r.dbManager.QueryRows(ctx, query, constants.ListAlerting, itemID, shopID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var favourites []Favourite
+	for rows.Next() {
+		var fav Favourite
+		err := rows.Scan(fav.fields()...)
+		if err != nil {
+			return favourites, err
+		}
+		favourites = append(favourites, fav)
+	}
+	return favourites, rows.Err()
+}
+
+// SetAlertedPrice updates the alertedPrice column of the row in the Favourites table.
+func (r *MySQLRepository) SetAlertedPrice(ctx context.Context, id int64, price int64) error {
+	query := "UPDATE Favourites SET alertedPrice=? WHERE id=?"
+	_, err := r.dbManager.UpdateRows(ctx, query, constants.SetAlertedPrice, price, id)
+	return err
+}
+
+// ClaimAlert updates the alertedPrice column of the row in the Favourites table, if it is 0 or higher than the price.
+func (r *MySQLRepository) ClaimAlert(ctx context.Context, id int64, price int64) (bool, error) {
+	query := "UPDATE Favourites SET alertedPrice=? WHERE id=? AND (alertedPrice=0 OR alertedPrice>?)"
+	updated, err := r.dbManager.UpdateRows(ctx, query, constants.ClaimAlert, price, id, price)
+	return updated == 1, err
+}
+
+// Count counts the user's rows in the Favourites table selected by the filter.
+func (r *MySQLRepository) Count(ctx context.Context, userID int64, filter FavouriteFilter) (int, error) {
+	where, args := filterClause(userID, filter)
+	query := "SELECT count(*) FROM Favourites WHERE " + where
+	var count int
+	err := r.dbManager.QueryOne(ctx, query, constants.GetFavCount, args, &count)
+	return count, err
+}
+
+// SetNoteAndTags updates the note column of the row in the Favourites table, and replaces the favourite's rows in the FavouriteTags table,
+// in a transaction.
+func (r *MySQLRepository) SetNoteAndTags(ctx context.Context, id int64, userID int64, note string, tags []string) error {
+	return r.dbManager.InTx(ctx, constants.SetNoteAndTags, func(ctx context.Context) error {
+		query := "UPDATE Favourites SET note=? WHERE id=? AND userID=?"
+		_, err := r.dbManager.UpdateRows(ctx, query, constants.SetNoteAndTags, note, id, userID)
+		if err != nil {
+			return err
+		}
+
+		query = "DELETE FROM FavouriteTags WHERE favouriteID=?"
+		_, err = r.dbManager.DeleteOne(ctx, query, constants.SetNoteAndTags, id)
+		if err != nil || len(tags) == 0 {
+			return err
+		}
+
+		query = "INSERT INTO FavouriteTags(favouriteID, userID, tag) VALUES" + strings.TrimSuffix(strings.Repeat(" (?, ?, ?),", len(tags)), ",")
+		args := make([]any, 0, 3*len(tags))
+		for _, tag := range tags {
+			args = append(args, id, userID, tag)
+		}
+		_, err = r.dbManager.InsertRow(ctx, query, constants.SetNoteAndTags, args...)
+		return err
+	})
+}
+
+// ListTags counts the user's rows in the FavouriteTags table for each tag.
+func (r *MySQLRepository) ListTags(ctx context.Context, userID int64) ([]TagCount, error) {
+	query := "SELECT tag, count(*) AS c FROM FavouriteTags WHERE userID=? GROUP BY tag ORDER BY c desc, tag"
+
+	// query rows
+	rows, err := r.dbManager.QueryRows(ctx, query, constants.ListTags, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []TagCount
+	for rows.Next() {
+		var tag TagCount
+		err := rows.Scan(&tag.Tag, &tag.Count)
+		if err != nil {
+			return tags, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, rows.Err()
+}
+
+// UpdateItemSnapshot updates the item columns of the item's rows in the Favourites table, if they changed.
+func (r *MySQLRepository) UpdateItemSnapshot(ctx context.Context, itemID int64, shopID int64, item ItemSnapshot) error {
+	query := "UPDATE Favourites SET itemName=?, itemPrice=? WHERE itemID=? AND shopID=? AND (itemName<>? OR itemPrice<>?)"
+	_, err := r.dbManager.UpdateRows(ctx, query, constants.UpdateItemSnapshot, item.Name, item.Price, itemID, shopID, item.Name, item.Price)
+	return err
+}
+
+// MoveFavourite updates the collectionID column of the user's row in the Favourites table.
+func (r *MySQLRepository) MoveFavourite(ctx context.Context, userID int64, itemID int64, shopID int64, collectionID int64) error {
+	query := "UPDATE Favourites SET collectionID=? WHERE userID=? AND itemID=? AND shopID=?"
+	_, err := r.dbManager.UpdateRows(ctx, query, constants.MoveFav, collectionID, userID, itemID, shopID)
+	return err
+}
+
+// CreateCollection inserts the collection into the Collections table.
+func (r *MySQLRepository) CreateCollection(ctx context.Context, userID int64, name string) (*Collection, error) {
+	query := "INSERT INTO Collections(userID, name) VALUES(?, ?)"
+	id, err := r.dbManager.InsertRow(ctx, query, constants.CreateCollection, userID, name)
+	if isDuplicateEntry(err) {
+		return nil, ErrDuplicateCollection
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.GetCollection(ctx, userID, id)
+}
+
+// GetCollection queries the Collections table for the user's collection.
+func (r *MySQLRepository) GetCollection(ctx context.Context, userID int64, collectionID int64) (*Collection, error) {
+	var collection Collection
+	query := "SELECT id, userID, name, timeCreated FROM Collections WHERE id=? AND userID=?"
+	err := r.dbManager.QueryOne(ctx, query, constants.GetCollection, []any{collectionID, userID}, &collection.ID, &collection.UserID, &collection.Name, &collection.TimeCreated)
+	if err != nil {
+		return nil, err
+	}
+	return &collection, err
+}
+
+// ListCollections queries the Collections table for the user's collections, counting their rows in the Favourites table.
+func (r *MySQLRepository) ListCollections(ctx context.Context, userID int64) ([]Collection, error) {
+	query := "SELECT c.id, c.userID, c.name, c.timeCreated, count(f.id) FROM Collections c LEFT JOIN Favourites f ON f.userID=c.userID AND f.collectionID=c.id WHERE c.userID=? GROUP BY c.id ORDER BY c.timeCreated, c.id"
+
+	// query rows
+	rows, err := r.dbManager.QueryRows(ctx, query, constants.ListCollections, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var collections []Collection
+	for rows.Next() {
+		var collection Collection
+		err := rows.Scan(&collection.ID, &collection.UserID, &collection.Name, &collection.TimeCreated, &collection.Count)
+		if err != nil {
+			return collections, err
+		}
+		collections = append(collections, collection)
+	}
+	return collections, rows.Err()
+}
+
+// RenameCollection updates the name column of the user's row in the Collections table.
+func (r *MySQLRepository) RenameCollection(ctx context.Context, userID int64, collectionID int64, name string) error {
+	query := "UPDATE Collections SET name=? WHERE id=? AND userID=?"
+	_, err := r.dbManager.UpdateRows(ctx, query, constants.RenameCollection, name, collectionID, userID)
+	if isDuplicateEntry(err) {
+		return ErrDuplicateCollection
+	}
+	return err
+}
+
+// DeleteCollection moves the favourites out of the collection, then deletes the user's row from the Collections table.
+// The favourites are moved first, so that a failed delete leaves an empty collection rather than favourites in a missing one.
+func (r *MySQLRepository) DeleteCollection(ctx context.Context, userID int64, collectionID int64) error {
+	query := "UPDATE Favourites SET collectionID=0 WHERE userID=? AND collectionID=?"
+	_, err := r.dbManager.UpdateRows(ctx, query, constants.DeleteCollection, userID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	query = "DELETE FROM Collections WHERE id=? AND userID=?"
+	_, err = r.dbManager.DeleteOne(ctx, query, constants.DeleteCollection, collectionID, userID)
+	return err
+}
+
+// DeleteAllCollections deletes all of the user's collections from the Collections table.
+func (r *MySQLRepository) DeleteAllCollections(ctx context.Context, userID int64) error {
+	query := "DELETE FROM Collections WHERE userID=?"
+	_, err := r.dbManager.DeleteOne(ctx, query, constants.DeleteAllCollections, userID)
+	return err
+}
+
+// TopFavourited counts the rows of each item in the Favourites table and returns the items with the most rows.
+func (r *MySQLRepository) TopFavourited(ctx context.Context, limit int) ([]FavouritedItem, error) {
+	query := "SELECT itemID, shopID, count(*) AS c FROM Favourites GROUP BY itemID, shopID ORDER BY c desc LIMIT ?"
+
+	// query rows
+	rows, err := r.dbManager.QueryRows(ctx, query, constants.TopFavourited, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []FavouritedItem
+	for rows.Next() {
+		var item FavouritedItem
+		err := rows.Scan(&item.ItemID, &item.ShopID, &item.Count)
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// AddPricePoint inserts the item's price into the PriceHistory table.
+func (r *MySQLRepository) AddPricePoint(ctx context.Context, itemID int64, shopID int64, price int64) error {
+	query := "INSERT INTO PriceHistory(itemID, shopID, price) VALUES(?, ?, ?)"
+	_, err := r.dbManager.InsertRow(ctx, query, constants.AddPricePoint, itemID, shopID, price)
+	return err
+}
+
+// LatestPricePoint queries the PriceHistory table for the item's most recently recorded price.
+func (r *MySQLRepository) LatestPricePoint(ctx context.Context, itemID int64, shopID int64) (*PricePoint, error) {
+	var point PricePoint
+	query := "SELECT * FROM PriceHistory WHERE itemID=? AND shopID=? ORDER BY timeRecorded desc, id desc LIMIT 1"
+	err := r.dbManager.QueryOne(ctx, query, constants.GetPriceHistory, []any{itemID, shopID}, &point.ID, &point.ItemID, &point.ShopID, &point.Price, &point.TimeRecorded)
+	if err != nil {
+		return nil, err
+	}
+	return &point, err
+}
+
+// ListPricePoints queries the PriceHistory table for the item's prices recorded between from and to, ordered by timeRecorded.
+func (r *MySQLRepository) ListPricePoints(ctx context.Context, itemID int64, shopID int64, from time.Time, to time.Time) ([]PricePoint, error) {
+	query := "SELECT * FROM PriceHistory WHERE itemID=? AND shopID=? AND timeRecorded BETWEEN ? AND ? ORDER BY timeRecorded, id"
+
+	// query rows
+	rows, err := r.dbManager.QueryRows(ctx, query, constants.GetPriceHistory, itemID, shopID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var points []PricePoint
+	for rows.Next() {
+		var point PricePoint
+		err := rows.Scan(&point.ID, &point.ItemID, &point.ShopID, &point.Price, &point.TimeRecorded)
+		if err != nil {
+			return points, err
+		}
+		points = append(points, point)
+	}
+	return points, rows.Err()
+}
+
+// loadTags queries the FavouriteTags table for the tags of the favourites, and sets them on the favourites.
+func (r *MySQLRepository) loadTags(ctx context.Context, favourites []Favourite) error {
+	if len(favourites) == 0 {
+		return nil
+	}
+
+	// index of each favourite by its ID
+	index := make(map[int64]int, len(favourites))
+	args := make([]any, len(favourites))
+	for i, fav := range favourites {
+		index[fav.ID] = i
+		args[i] = fav.ID
+	}
+	query := "SELECT favouriteID, tag FROM FavouriteTags WHERE favouriteID IN (" + placeholders(len(favourites)) + ") ORDER BY tag"
+
+	// query rows
+	rows, err := r.dbManager.QueryRows(ctx, query, constants.GetTags, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var tag string
+		err := rows.Scan(&id, &tag)
+		if err != nil {
+			return err
+		}
+		fav := &favourites[index[id]]
+		fav.Tags = append(fav.Tags, tag)
+	}
+	return rows.Err()
+}
+
+// filterClause returns the WHERE clause and its placeholder arguments selecting the user's favourites by the filter.
+func filterClause(userID int64, filter FavouriteFilter) (string, []any) {
+	where := "userID=?"
+	args := []any{userID}
+	if filter.CollectionID != 0 {
+		where += " AND collectionID=?"
+		args = append(args, filter.CollectionID)
+	}
+	if filter.Tag != "" {
+		where += " AND id IN (SELECT favouriteID FROM FavouriteTags WHERE userID=? AND tag=?)"
+		args = append(args, userID, filter.Tag)
+	}
+	if filter.ShopID != 0 {
+		where += " AND shopID=?"
+		args = append(args, filter.ShopID)
+	}
+	if filter.MinPrice != 0 {
+		where += " AND itemPrice>=?"
+		args = append(args, filter.MinPrice)
+	}
+	if filter.MaxPrice != 0 {
+		where += " AND itemPrice<=?"
+		args = append(args, filter.MaxPrice)
+	}
+	return where, args
+}
+
+// orderClause returns the ORDER BY clause for the sort order, with ties broken by id.
+func orderClause(sort FavouriteSort) string {
+	switch sort {
+	case SortAddedAsc:
+		return "timeAdded, id"
+	case SortPriceAsc:
+		return "itemPrice, id"
+	case SortPriceDesc:
+		return "itemPrice desc, id desc"
+	case SortNameAsc:
+		return "itemName, id"
+	case SortNameDesc:
+		return "itemName desc, id desc"
+	default:
+		return "timeAdded desc, id desc"
+	}
+}
+
+// placeholders returns n comma separated placeholders.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// fields returns pointers to the favourite's fields, in the order of favouriteColumns.
+func (fav *Favourite) fields() []any {
+	return []any{&fav.ID, &fav.UserID, &fav.ItemID, &fav.ShopID, &fav.TimeAdded, &fav.CollectionID, &fav.Note, &fav.Item.Name, &fav.Item.Price, &fav.TargetPrice, &fav.DropPercent, &fav.BasePrice, &fav.AlertedPrice}
+}
+
+// isDuplicateEntry checks if err is caused by a violated unique key.
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
@@ -0,0 +1,12 @@
+package db
+
+import "time"
+
+// PricePoint is a recorded price of an item. It follows the schema in schema/mysql.sql
+type PricePoint struct {
+	ID           int64
+	ItemID       int64
+	ShopID       int64
+	Price        int64
+	TimeRecorded time.Time
+}
@@ -8,6 +8,7 @@ import (
 	errors "itemService/errors"
 	metrics "itemService/metrics"
 	"itemService/tracing"
+	"strings"
 	"time"
 
 	ot "github.com/opentracing/opentracing-go"
@@ -19,8 +20,11 @@ import (
 )
 
 const (
-	redisSet = "redis.Set"
-	redisGet = "redis.Get"
+	redisSet  = "redis.Set"
+	redisGet  = "redis.Get"
+	redisMGet = "redis.MGet"
+	redisDel  = "redis.Del"
+	redisTTL  = "redis.TTL"
 )
 
 // RedisManager is a struct containing a reference to the redis client, logger, and the redis config
@@ -104,8 +108,9 @@ func (rm *RedisManager) Set(ctx context.Context, key string, bytes []byte, exp t
 	return nil
 }
 
-// Get takes a key and returns its associated value in bytes.
-func (rm *RedisManager) Get(ctx context.Context, key string) ([]byte, error) {
+// Get takes a key and returns its associated value in bytes, along with its remaining time to live.
+// The value and time to live are read in a single round trip. The time to live is -1 if the key has no expiry.
+func (rm *RedisManager) Get(ctx context.Context, key string) ([]byte, time.Duration, error) {
 	// start tracing span from context
 	span, ctx := ot.StartSpanFromContext(ctx, redisGet)
 	statement := fmt.Sprintf(tracing.DatabaseStatementRedisGet, key)
@@ -121,25 +126,33 @@ func (rm *RedisManager) Get(ctx context.Context, key string) ([]byte, error) {
 	}()
 
 	// call the redis client
-	bytes, err := rm.client.Get(ctx, key).Bytes()
-	if err != nil {
-		if err != redis.Nil {
-			// unexpected error occured when getting item
-			rm.logger.Error(
-				constants.ErrorRedisGetMsg,
-				zap.String(constants.Key, key),
-				zap.Error(err),
-			)
-			// set success to false only if unexpected error occured
-			successStr = constants.False
-			return nil, errors.Error{constants.ErrorRedisGet, constants.ErrorRedisGetMsg, err}
-		}
+	var get *redis.StringCmd
+	var pttl *redis.DurationCmd
+	_, err := rm.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, key)
+		pttl = pipe.PTTL(ctx, key)
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		// unexpected error occured when getting item
+		rm.logger.Error(
+			constants.ErrorRedisGetMsg,
+			zap.String(constants.Key, key),
+			zap.Error(err),
+		)
+		// set success to false only if unexpected error occured
+		successStr = constants.False
+		return nil, 0, errors.Error{constants.ErrorRedisGet, constants.ErrorRedisGetMsg, err}
+	}
+
+	bytes, err := get.Bytes()
+	if err == redis.Nil {
 		rm.logger.Info(
 			constants.InfoItemNotInRedis,
 			zap.String(constants.Key, key),
 		)
 		// item is not in redis
-		return nil, nil
+		return nil, 0, nil
 	}
 
 	rm.logger.Info(
@@ -147,7 +160,133 @@ func (rm *RedisManager) Get(ctx context.Context, key string) ([]byte, error) {
 		zap.String(constants.Key, key),
 		zap.ByteString(constants.Bytes, bytes),
 	)
-	return bytes, err
+	return bytes, pttl.Val(), err
+}
+
+// MGet takes a list of keys and returns their associated values in bytes, in the same order as the keys,
+// along with their remaining times to live. The value of a key that is not in redis is nil.
+// All the keys and times to live are fetched in a single round trip.
+func (rm *RedisManager) MGet(ctx context.Context, keys []string) ([][]byte, []time.Duration, error) {
+	if len(keys) == 0 {
+		return nil, nil, nil
+	}
+
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, redisMGet)
+	statement := fmt.Sprintf(tracing.DatabaseStatementRedisMGet, strings.Join(keys, " "))
+	rm.addSpanTags(span, statement)
+	defer span.Finish()
+	successStr := constants.True
+	// time redis op
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RedisOpDuration.WithLabelValues(rm.config.ServiceLabel, constants.MGet, successStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// call the redis client
+	var mget *redis.SliceCmd
+	pttls := make([]*redis.DurationCmd, len(keys))
+	_, err := rm.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		mget = pipe.MGet(ctx, keys...)
+		for i, key := range keys {
+			pttls[i] = pipe.PTTL(ctx, key)
+		}
+		return nil
+	})
+	if err != nil {
+		// unexpected error occured when getting items
+		rm.logger.Error(
+			constants.ErrorRedisGetMsg,
+			zap.Strings(constants.Keys, keys),
+			zap.Error(err),
+		)
+		successStr = constants.False
+		return nil, nil, errors.Error{constants.ErrorRedisGet, constants.ErrorRedisGetMsg, err}
+	}
+
+	values := make([][]byte, len(keys))
+	ttls := make([]time.Duration, len(keys))
+	for i, val := range mget.Val() {
+		// missing keys are returned as nil, values as strings
+		if s, ok := val.(string); ok {
+			values[i] = []byte(s)
+			ttls[i] = pttls[i].Val()
+		}
+	}
+
+	rm.logger.Info(
+		constants.InfoRedisGet,
+		zap.Strings(constants.Keys, keys),
+	)
+	return values, ttls, nil
+}
+
+// Del removes the keys from redis.
+func (rm *RedisManager) Del(ctx context.Context, keys ...string) error {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, redisDel)
+	statement := fmt.Sprintf(tracing.DatabaseStatementRedisDel, strings.Join(keys, " "))
+	rm.addSpanTags(span, statement)
+	defer span.Finish()
+	successStr := constants.True
+	// time redis op
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RedisOpDuration.WithLabelValues(rm.config.ServiceLabel, constants.Del, successStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// call the redis client
+	err := rm.client.Del(ctx, keys...).Err()
+	if err != nil {
+		rm.logger.Error(
+			constants.ErrorRedisDelMsg,
+			zap.Strings(constants.Keys, keys),
+			zap.Error(err),
+		)
+		successStr = constants.False
+		return errors.Error{constants.ErrorRedisDel, constants.ErrorRedisDelMsg, err}
+	}
+
+	rm.logger.Info(
+		constants.InfoRedisDel,
+		zap.Strings(constants.Keys, keys),
+	)
+	return nil
+}
+
+// TTL returns the remaining time to live of the key.
+// It returns -2 if the key is not in redis, and -1 if the key has no expiry.
+func (rm *RedisManager) TTL(ctx context.Context, key string) (time.Duration, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, redisTTL)
+	statement := fmt.Sprintf(tracing.DatabaseStatementRedisTTL, key)
+	rm.addSpanTags(span, statement)
+	defer span.Finish()
+	successStr := constants.True
+	// time redis op
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RedisOpDuration.WithLabelValues(rm.config.ServiceLabel, constants.TTL, successStr).Observe(v)
+	}))
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// call the redis client
+	ttl, err := rm.client.TTL(ctx, key).Result()
+	if err != nil {
+		rm.logger.Error(
+			constants.ErrorRedisGetMsg,
+			zap.String(constants.Key, key),
+			zap.Error(err),
+		)
+		successStr = constants.False
+		return 0, errors.Error{constants.ErrorRedisGet, constants.ErrorRedisGetMsg, err}
+	}
+	return ttl, nil
 }
 
 func (rm *RedisManager) addSpanTags(span ot.Span, statement string) {
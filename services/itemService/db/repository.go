@@ -0,0 +1,118 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"itemService/config"
+	"itemService/constants"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrDuplicateFavourite is returned when adding an item that is already in the user's favourites.
+var ErrDuplicateFavourite = errors.New("duplicate favourite")
+
+// ErrDuplicateCollection is returned when creating or renaming a collection to a name the user already has a collection with.
+var ErrDuplicateCollection = errors.New("duplicate collection")
+
+// FavouritesRepository stores and retrieves users' favourited items.
+type FavouritesRepository interface {
+	// Add adds the item to the user's favourites with the item's information and the alert rule, and returns the ID of the new favourite.
+	// It returns ErrDuplicateFavourite if the item is already in the user's favourites.
+	Add(ctx context.Context, userID int64, itemID int64, shopID int64, item ItemSnapshot, rule AlertRule) (int64, error)
+	// BatchAdd adds the items to the user's favourites in a single transaction, and returns the ID of each new favourite in order.
+	// The ID is 0 for an item that is already in the user's favourites. No favourites are added if it returns an error.
+	BatchAdd(ctx context.Context, userID int64, favourites []NewFavourite) ([]int64, error)
+	// Get returns the user's favourite for the given item.
+	// It returns sql.ErrNoRows if the item is not in the user's favourites.
+	Get(ctx context.Context, userID int64, itemID int64, shopID int64) (*Favourite, error)
+	// Delete removes the item from the user's favourites and returns the number of favourites removed.
+	Delete(ctx context.Context, userID int64, itemID int64, shopID int64) (int64, error)
+	// BatchDelete removes the items from the user's favourites in a single transaction, and reports whether each item was removed in order.
+	// No favourites are removed if it returns an error.
+	BatchDelete(ctx context.Context, userID int64, items []ItemKey) ([]bool, error)
+	// DeleteAll removes all of the user's favourites and returns the number of favourites removed.
+	DeleteAll(ctx context.Context, userID int64) (int64, error)
+	// List returns a page of the user's favourites selected by the filter, in the sort order.
+	// Favourites that sort equally are ordered by ID, so that pages do not overlap.
+	List(ctx context.Context, userID int64, filter FavouriteFilter, sort FavouriteSort, limit int, offset int) ([]Favourite, error)
+	// ListAfter returns up to limit of the user's favourites selected by the filter that come after the cursor in the sort order,
+	// or the first favourites if the cursor is nil. The sort order must be SortAddedDesc or SortAddedAsc.
+	ListAfter(ctx context.Context, userID int64, filter FavouriteFilter, sort FavouriteSort, after *FavouriteCursor, limit int) ([]Favourite, error)
+	// Count returns the total number of the user's favourites selected by the filter.
+	Count(ctx context.Context, userID int64, filter FavouriteFilter) (int, error)
+	// SetNoteAndTags replaces the note and tags of the favourite with the ID, which belongs to the user.
+	SetNoteAndTags(ctx context.Context, id int64, userID int64, note string, tags []string) error
+	// ListTags returns the number of the user's favourites with each tag, the most used tag first.
+	ListTags(ctx context.Context, userID int64) ([]TagCount, error)
+	// UpdateItemSnapshot updates the item's information in every favourite of the item.
+	UpdateItemSnapshot(ctx context.Context, itemID int64, shopID int64, item ItemSnapshot) error
+	// MoveFavourite moves the user's favourite into the collection, or out of any collection if collectionID is 0.
+	MoveFavourite(ctx context.Context, userID int64, itemID int64, shopID int64, collectionID int64) error
+	// UpdateAlertRule replaces the alert rule of the user's favourite.
+	// The favourite's alerted price is reset, so that the user can be alerted again under the new rule.
+	UpdateAlertRule(ctx context.Context, userID int64, itemID int64, shopID int64, rule AlertRule) error
+	// ListAlerting returns the favourites of the item with an alert rule set.
+	ListAlerting(ctx context.Context, itemID int64, shopID int64) ([]Favourite, error)
+	// SetAlertedPrice sets the price the favourite's user was last alerted at.
+	SetAlertedPrice(ctx context.Context, id int64, price int64) error
+	// ClaimAlert sets the favourite's alerted price to price if its user was not alerted yet, or alerted at a higher price.
+	// It reports whether the alert was claimed, so that concurrent evaluations of the same price alert the user once.
+	ClaimAlert(ctx context.Context, id int64, price int64) (bool, error)
+	// TopFavourited returns up to limit items that are in the most users' favourites, the most favourited first.
+	TopFavourited(ctx context.Context, limit int) ([]FavouritedItem, error)
+}
+
+// CollectionsRepository stores and retrieves the collections users sort their favourites into.
+type CollectionsRepository interface {
+	// CreateCollection creates a collection for the user and returns it.
+	// It returns ErrDuplicateCollection if the user already has a collection with the name.
+	CreateCollection(ctx context.Context, userID int64, name string) (*Collection, error)
+	// GetCollection returns the user's collection.
+	// It returns sql.ErrNoRows if the user has no collection with the ID.
+	GetCollection(ctx context.Context, userID int64, collectionID int64) (*Collection, error)
+	// ListCollections returns the user's collections with the number of favourites in each, the oldest first.
+	ListCollections(ctx context.Context, userID int64) ([]Collection, error)
+	// RenameCollection renames the user's collection.
+	// It returns ErrDuplicateCollection if the user already has another collection with the name.
+	RenameCollection(ctx context.Context, userID int64, collectionID int64, name string) error
+	// DeleteCollection deletes the user's collection. The favourites in the collection are kept and moved out of any collection.
+	DeleteCollection(ctx context.Context, userID int64, collectionID int64) error
+	// DeleteAllCollections deletes all of the user's collections.
+	DeleteAllCollections(ctx context.Context, userID int64) error
+}
+
+// PriceHistoryRepository stores and retrieves the prices recorded for items.
+type PriceHistoryRepository interface {
+	// AddPricePoint records the item's price at the current time.
+	AddPricePoint(ctx context.Context, itemID int64, shopID int64, price int64) error
+	// LatestPricePoint returns the item's most recently recorded price.
+	// It returns sql.ErrNoRows if no price has been recorded for the item.
+	LatestPricePoint(ctx context.Context, itemID int64, shopID int64) (*PricePoint, error)
+	// ListPricePoints returns the item's prices recorded between from and to inclusive, the oldest first.
+	ListPricePoints(ctx context.Context, itemID int64, shopID int64, from time.Time, to time.Time) ([]PricePoint, error)
+}
+
+// Repository is implemented by each database backend, and holds all the data stored by the service.
+type Repository interface {
+	FavouritesRepository
+	CollectionsRepository
+	PriceHistoryRepository
+}
+
+// InitRepository returns the Repository for the backend set in the database config.
+// The MySQL backend is used unless the in-memory backend is selected.
+func InitRepository(dbConfig *config.DbConfig, logger *zap.Logger) (Repository, error) {
+	if dbConfig.Backend == constants.Memory {
+		logger.Info(constants.InfoDatabaseMemoryBackend)
+		return NewMemoryRepository(), nil
+	}
+
+	// connect to database, get database manager
+	dbManager, err := InitDatabase(dbConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	return NewMySQLRepository(dbManager), nil
+}
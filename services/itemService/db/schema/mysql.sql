@@ -9,6 +9,7 @@ CREATE USER IF NOT EXISTS 'exporter'@'%' IDENTIFIED BY 'exporterpassword' WITH M
 GRANT PROCESS, REPLICATION CLIENT, SELECT ON *.* TO 'exporter'@'%';
 
 USE itemservicedb;
+DROP TABLE IF EXISTS FavouriteTags;
 DROP TABLE IF EXISTS Favourites;
 CREATE TABLE Favourites (
     id bigint unsigned AUTO_INCREMENT PRIMARY KEY,
@@ -16,8 +17,50 @@ CREATE TABLE Favourites (
     itemID bigint NOT NULL,
     shopID bigint NOT NULL,
     timeAdded TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    collectionID bigint unsigned NOT NULL DEFAULT 0,
+    note varchar(500) NOT NULL DEFAULT '',
+    itemName varchar(255) NOT NULL DEFAULT '',
+    itemPrice bigint NOT NULL DEFAULT 0,
+    targetPrice bigint NOT NULL DEFAULT 0,
+    dropPercent int NOT NULL DEFAULT 0,
+    basePrice bigint NOT NULL DEFAULT 0,
+    alertedPrice bigint NOT NULL DEFAULT 0,
     UNIQUE(userID, shopID, itemID)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
  
 CREATE INDEX userId_timeAdded_idx ON Favourites(userID, timeAdded);
-CREATE INDEX userId_item_idx ON Favourites(userID, itemID, shopID);
\ No newline at end of file
+CREATE INDEX userId_item_idx ON Favourites(userID, itemID, shopID);
+CREATE INDEX item_idx ON Favourites(itemID, shopID);
+CREATE INDEX userId_collection_timeAdded_idx ON Favourites(userID, collectionID, timeAdded);
+CREATE INDEX userId_itemPrice_idx ON Favourites(userID, itemPrice);
+CREATE INDEX userId_itemName_idx ON Favourites(userID, itemName);
+
+CREATE TABLE FavouriteTags (
+    favouriteID bigint unsigned NOT NULL,
+    userID bigint unsigned NOT NULL,
+    tag varchar(32) NOT NULL,
+    PRIMARY KEY(favouriteID, tag),
+    FOREIGN KEY(favouriteID) REFERENCES Favourites(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX userId_tag_idx ON FavouriteTags(userID, tag);
+
+DROP TABLE IF EXISTS Collections;
+CREATE TABLE Collections (
+    id bigint unsigned AUTO_INCREMENT PRIMARY KEY,
+    userID bigint unsigned NOT NULL,
+    name varchar(64) NOT NULL,
+    timeCreated TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(userID, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+DROP TABLE IF EXISTS PriceHistory;
+CREATE TABLE PriceHistory (
+    id bigint unsigned AUTO_INCREMENT PRIMARY KEY,
+    itemID bigint NOT NULL,
+    shopID bigint NOT NULL,
+    price bigint NOT NULL,
+    timeRecorded TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX item_timeRecorded_idx ON PriceHistory(itemID, shopID, timeRecorded);
\ No newline at end of file
@@ -0,0 +1,127 @@
+package db
+
+import (
+	"context"
+	"itemService/config"
+	constants "itemService/constants"
+	metrics "itemService/metrics"
+	"time"
+)
+
+// TieredCache is a two-tier cache with an in-process LocalCache in front of redis.
+// Reads are served from the local tier if possible, and values read from redis are added to the local tier.
+// Writes and deletes go to both tiers.
+// A value read from redis is kept in the local tier no longer than its remaining time to live in redis.
+type TieredCache struct {
+	local *LocalCache
+	redis RedisTier
+}
+
+// RedisTier is the part of the RedisManager used by the TieredCache.
+type RedisTier interface {
+	Get(ctx context.Context, key string) ([]byte, time.Duration, error)
+	MGet(ctx context.Context, keys []string) ([][]byte, []time.Duration, error)
+	Set(ctx context.Context, key string, bytes []byte, exp time.Duration) error
+	Del(ctx context.Context, keys ...string) error
+	TTL(ctx context.Context, key string) (time.Duration, error)
+}
+
+// NewTieredCache returns a TieredCache in front of redis, usually a RedisManager, with the local tier's size and expiry set in the config.
+func NewTieredCache(redisConfig *config.RedisConfig, redis RedisTier) *TieredCache {
+	return &TieredCache{
+		local: NewLocalCache(redisConfig.LocalSize, time.Duration(redisConfig.LocalExpire)*time.Second),
+		redis: redis,
+	}
+}
+
+// Get returns the value of the key, or nil if the key is in neither tier.
+func (tc *TieredCache) Get(ctx context.Context, key string) ([]byte, error) {
+	if bytes, ok := tc.local.Get(key); ok {
+		metrics.CacheRequests.WithLabelValues(constants.Local, constants.Hit).Inc()
+		return bytes, nil
+	}
+	metrics.CacheRequests.WithLabelValues(constants.Local, constants.Miss).Inc()
+
+	bytes, ttl, err := tc.redis.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	tc.observeRedis(key, bytes, ttl)
+	return bytes, nil
+}
+
+// MGet returns the values of the keys in the same order as the keys. The value of a key that is in neither tier is nil.
+// The keys that are not in the local tier are fetched from redis in a single round trip.
+func (tc *TieredCache) MGet(ctx context.Context, keys []string) ([][]byte, error) {
+	values := make([][]byte, len(keys))
+
+	// indexes of the keys that are not in the local tier
+	var misses []int
+	var missKeys []string
+	for i, key := range keys {
+		if bytes, ok := tc.local.Get(key); ok {
+			metrics.CacheRequests.WithLabelValues(constants.Local, constants.Hit).Inc()
+			values[i] = bytes
+			continue
+		}
+		metrics.CacheRequests.WithLabelValues(constants.Local, constants.Miss).Inc()
+		misses = append(misses, i)
+		missKeys = append(missKeys, key)
+	}
+
+	if len(missKeys) == 0 {
+		return values, nil
+	}
+
+	redisValues, ttls, err := tc.redis.MGet(ctx, missKeys)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range misses {
+		tc.observeRedis(keys[i], redisValues[j], ttls[j])
+		values[i] = redisValues[j]
+	}
+	return values, nil
+}
+
+// Set sets the value of the key in both tiers. exp is used to define an expiry.
+func (tc *TieredCache) Set(ctx context.Context, key string, bytes []byte, exp time.Duration) error {
+	err := tc.redis.Set(ctx, key, bytes, exp)
+	if err != nil {
+		// drop the local copy so that it does not outlive the value in redis
+		tc.local.Del(key)
+		return err
+	}
+	tc.local.Set(key, bytes, exp)
+	return nil
+}
+
+// Del removes the keys from both tiers.
+func (tc *TieredCache) Del(ctx context.Context, keys ...string) error {
+	tc.local.Del(keys...)
+	return tc.redis.Del(ctx, keys...)
+}
+
+// TTL returns the remaining time to live of the key in redis.
+// It returns -2 if the key is not in redis, and -1 if the key has no expiry.
+func (tc *TieredCache) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return tc.redis.TTL(ctx, key)
+}
+
+// observeRedis records whether the key was found in redis, and adds the value to the local tier if it was.
+// The local copy expires with the value in redis, or after the local tier's expiry if that is sooner.
+func (tc *TieredCache) observeRedis(key string, bytes []byte, ttl time.Duration) {
+	if bytes == nil {
+		metrics.CacheRequests.WithLabelValues(constants.Redis, constants.Miss).Inc()
+		return
+	}
+	metrics.CacheRequests.WithLabelValues(constants.Redis, constants.Hit).Inc()
+	switch {
+	case ttl == -1:
+		// the value has no expiry in redis
+		tc.local.Set(key, bytes, 0)
+	case ttl > 0:
+		tc.local.Set(key, bytes, ttl)
+	}
+	// otherwise the value expired in redis right after it was read, so it is not kept
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeRedis is a RedisTier backed by a MemoryCache. It counts the values read from it.
+type fakeRedis struct {
+	*MemoryCache
+	reads int
+}
+
+func (r *fakeRedis) Get(ctx context.Context, key string) ([]byte, time.Duration, error) {
+	r.reads++
+	bytes, _ := r.MemoryCache.Get(ctx, key)
+	ttl, _ := r.MemoryCache.TTL(ctx, key)
+	return bytes, ttl, nil
+}
+
+func (r *fakeRedis) MGet(ctx context.Context, keys []string) ([][]byte, []time.Duration, error) {
+	values := make([][]byte, len(keys))
+	ttls := make([]time.Duration, len(keys))
+	for i, key := range keys {
+		values[i], ttls[i], _ = r.Get(ctx, key)
+	}
+	return values, ttls, nil
+}
+
+func TestTieredCacheLocalCopyExpiresWithRedis(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	clock := func() time.Time { return now }
+	redis := &fakeRedis{MemoryCache: NewMemoryCache()}
+	redis.now = clock
+	tc := &TieredCache{local: NewLocalCache(10, time.Minute), redis: redis}
+	tc.local.now = clock
+
+	// written by another instance, so only in redis
+	redis.Set(ctx, "short", []byte("1"), 2*time.Second)
+	redis.Set(ctx, "forever", []byte("2"), 0)
+	if _, err := tc.MGet(ctx, []string{"short", "forever"}); err != nil {
+		t.Fatalf("MGet: %v", err)
+	}
+	if redis.reads != 2 {
+		t.Fatalf("MGet: got %d redis reads, want 2", redis.reads)
+	}
+
+	// both are served locally until the short one expires in redis
+	now = now.Add(time.Second)
+	tc.MGet(ctx, []string{"short", "forever"})
+	if redis.reads != 2 {
+		t.Errorf("before expiry: got %d redis reads, want 2", redis.reads)
+	}
+	now = now.Add(time.Second)
+	if bytes, _ := tc.Get(ctx, "short"); bytes != nil {
+		t.Errorf("Get(short) after expiry in redis: got %q, want nil", bytes)
+	}
+	if redis.reads != 3 {
+		t.Errorf("after expiry: got %d redis reads, want 3", redis.reads)
+	}
+
+	// a key without expiry in redis is kept for the local tier's expiry
+	now = now.Add(time.Minute)
+	tc.Get(ctx, "forever")
+	if redis.reads != 4 {
+		t.Errorf("after local expiry: got %d redis reads, want 4", redis.reads)
+	}
+}
@@ -16,3 +16,8 @@ func (err Error) Error() string {
 	}
 	return err.ErrorMsg
 }
+
+// Unwrap returns the underlying error
+func (err Error) Unwrap() error {
+	return err.Err
+}
@@ -0,0 +1,275 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format is the file format favourites are exported to and imported from.
+type Format int
+
+const (
+	// JSON writes the favourites as a JSON array of records
+	JSON Format = iota
+	// CSV writes the favourites as CSV with a header row, with a record's tags joined by tagSeparator
+	CSV
+)
+
+// tagSeparator joins a record's tags in a CSV field.
+const tagSeparator = "|"
+
+// header is the header row of a CSV file, in the order of the fields written for a record.
+var header = []string{"itemID", "shopID", "name", "price", "timeAdded", "note", "tags", "targetPrice", "dropPercent"}
+
+// ErrTooManyRecords is returned when reading a file with more records than the limit.
+var ErrTooManyRecords = errors.New("too many records")
+
+// Record is a favourite as written to an export file and read from an import file.
+type Record struct {
+	ItemID int64  `json:"itemID"`
+	ShopID int64  `json:"shopID"`
+	Name   string `json:"name"`
+	Price  int64  `json:"price"`
+	// TimeAdded is the time the favourite was added, or the zero time if it is not known
+	TimeAdded   time.Time `json:"timeAdded"`
+	Note        string    `json:"note"`
+	Tags        []string  `json:"tags"`
+	TargetPrice int64     `json:"targetPrice"`
+	DropPercent int32     `json:"dropPercent"`
+}
+
+// Valid reports whether the format is a known format.
+func (f Format) Valid() bool {
+	return f == JSON || f == CSV
+}
+
+// Writer writes records to an export file.
+type Writer interface {
+	// Write writes the record to the file.
+	Write(record Record) error
+	// Flush writes any buffered records to the underlying writer.
+	Flush() error
+	// Close ends the file and flushes the writer. It must be called after the last record is written.
+	Close() error
+}
+
+// NewWriter returns a Writer that writes a file in the format to w.
+func NewWriter(w io.Writer, format Format) Writer {
+	if format == CSV {
+		return &csvWriter{w: csv.NewWriter(w)}
+	}
+	return &jsonWriter{w: w}
+}
+
+// jsonWriter writes a JSON array with one record per line.
+type jsonWriter struct {
+	w       io.Writer
+	started bool
+}
+
+func (j *jsonWriter) Write(record Record) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	sep := ",\n"
+	if !j.started {
+		sep = "[\n"
+		j.started = true
+	}
+	_, err = io.WriteString(j.w, sep+string(b))
+	return err
+}
+
+func (j *jsonWriter) Flush() error {
+	return nil
+}
+
+func (j *jsonWriter) Close() error {
+	end := "\n]\n"
+	if !j.started {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(j.w, end)
+	return err
+}
+
+// csvWriter writes the header row before the first record.
+type csvWriter struct {
+	w       *csv.Writer
+	started bool
+}
+
+func (c *csvWriter) Write(record Record) error {
+	err := c.writeHeader()
+	if err != nil {
+		return err
+	}
+	var timeAdded string
+	if !record.TimeAdded.IsZero() {
+		timeAdded = record.TimeAdded.UTC().Format(time.RFC3339)
+	}
+	return c.w.Write([]string{
+		strconv.FormatInt(record.ItemID, 10),
+		strconv.FormatInt(record.ShopID, 10),
+		record.Name,
+		strconv.FormatInt(record.Price, 10),
+		timeAdded,
+		record.Note,
+		strings.Join(record.Tags, tagSeparator),
+		strconv.FormatInt(record.TargetPrice, 10),
+		strconv.FormatInt(int64(record.DropPercent), 10),
+	})
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Close() error {
+	err := c.writeHeader()
+	if err != nil {
+		return err
+	}
+	return c.Flush()
+}
+
+func (c *csvWriter) writeHeader() error {
+	if c.started {
+		return nil
+	}
+	c.started = true
+	return c.w.Write(header)
+}
+
+// Read reads the records of a file in the format from r.
+// It returns ErrTooManyRecords if the file has more than max records, or an error if the file is malformed.
+func Read(r io.Reader, format Format, max int) ([]Record, error) {
+	if format == CSV {
+		return readCSV(r, max)
+	}
+	return readJSON(r, max)
+}
+
+// readJSON decodes the records of the array one at a time, so that a file over the limit is not decoded in full.
+func readJSON(r io.Reader, max int) ([]Record, error) {
+	dec := json.NewDecoder(r)
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if token != json.Delim('[') {
+		return nil, errors.New("file is not a JSON array")
+	}
+
+	var records []Record
+	for dec.More() {
+		if len(records) == max {
+			return nil, ErrTooManyRecords
+		}
+		var record Record
+		err = dec.Decode(&record)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", len(records)+1, err)
+		}
+		records = append(records, record)
+	}
+	_, err = dec.Token()
+	return records, err
+}
+
+// readCSV reads the fields of each row by the columns of the header row, which can be in any order.
+// The itemID and shopID columns are required, and missing columns are left as their zero values.
+func readCSV(r io.Reader, max int) ([]Record, error) {
+	reader := csv.NewReader(r)
+	names, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int, len(names))
+	for i, name := range names {
+		columns[strings.TrimSpace(name)] = i
+	}
+	if _, ok := columns["itemID"]; !ok {
+		return nil, errors.New("missing itemID column")
+	}
+	if _, ok := columns["shopID"]; !ok {
+		return nil, errors.New("missing shopID column")
+	}
+
+	var records []Record
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(records) == max {
+			return nil, ErrTooManyRecords
+		}
+		record, err := parseRow(row, columns)
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		records = append(records, record)
+	}
+}
+
+// parseRow parses the record from the fields of the row.
+func parseRow(row []string, columns map[string]int) (Record, error) {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok {
+			return ""
+		}
+		return row[i]
+	}
+	integer := func(name string, bitSize int) (int64, error) {
+		s := field(name)
+		if s == "" {
+			return 0, nil
+		}
+		return strconv.ParseInt(s, 10, bitSize)
+	}
+
+	var record Record
+	var err error
+	if record.ItemID, err = integer("itemID", 64); err != nil {
+		return record, err
+	}
+	if record.ShopID, err = integer("shopID", 64); err != nil {
+		return record, err
+	}
+	if record.Price, err = integer("price", 64); err != nil {
+		return record, err
+	}
+	if record.TargetPrice, err = integer("targetPrice", 64); err != nil {
+		return record, err
+	}
+	dropPercent, err := integer("dropPercent", 32)
+	if err != nil {
+		return record, err
+	}
+	record.DropPercent = int32(dropPercent)
+	if s := field("timeAdded"); s != "" {
+		if record.TimeAdded, err = time.Parse(time.RFC3339, s); err != nil {
+			return record, err
+		}
+	}
+	if s := field("tags"); s != "" {
+		record.Tags = strings.Split(s, tagSeparator)
+	}
+	record.Name = field("name")
+	record.Note = field("note")
+	return record, nil
+}
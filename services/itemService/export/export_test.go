@@ -0,0 +1,102 @@
+package export
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteRead(t *testing.T) {
+	records := []Record{
+		{ItemID: 1, ShopID: 10, Name: "lamp, desk", Price: 1500, TimeAdded: time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC), Note: "for the \"study\"", Tags: []string{"home", "gift"}, TargetPrice: 1000},
+		{ItemID: 2, ShopID: 20, Name: "mug", Price: 300, DropPercent: 10},
+	}
+
+	for _, format := range []Format{JSON, CSV} {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, format)
+		for _, record := range records {
+			if err := w.Write(record); err != nil {
+				t.Fatalf("format %d: Write: unexpected error %v", format, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("format %d: Close: unexpected error %v", format, err)
+		}
+
+		got, err := Read(&buf, format, len(records))
+		if err != nil {
+			t.Fatalf("format %d: Read: unexpected error %v", format, err)
+		}
+		if !reflect.DeepEqual(got, records) {
+			t.Errorf("format %d: Read: got %+v, want %+v", format, got, records)
+		}
+	}
+}
+
+func TestWriteEmpty(t *testing.T) {
+	for _, format := range []Format{JSON, CSV} {
+		var buf bytes.Buffer
+		if err := NewWriter(&buf, format).Close(); err != nil {
+			t.Fatalf("format %d: Close: unexpected error %v", format, err)
+		}
+		got, err := Read(&buf, format, 1)
+		if err != nil || len(got) != 0 {
+			t.Errorf("format %d: Read: got (%+v, %v), want no records", format, got, err)
+		}
+	}
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  Format
+		file    string
+		want    []Record
+		wantErr bool
+	}{
+		{
+			name:   "csv columns in any order",
+			format: CSV,
+			file:   "shopID,itemID\n10,1\n",
+			want:   []Record{{ItemID: 1, ShopID: 10}},
+		},
+		{
+			name:    "csv missing itemID column",
+			format:  CSV,
+			file:    "shopID,name\n10,lamp\n",
+			wantErr: true,
+		},
+		{
+			name:    "csv malformed number",
+			format:  CSV,
+			file:    "itemID,shopID\n1,ten\n",
+			wantErr: true,
+		},
+		{
+			name:    "json not an array",
+			format:  JSON,
+			file:    `{"itemID": 1}`,
+			wantErr: true,
+		},
+		{
+			name:    "too many records",
+			format:  JSON,
+			file:    `[{"itemID": 1}, {"itemID": 2}, {"itemID": 3}]`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := Read(strings.NewReader(tt.file), tt.format, 2)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: got error %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package external
+
+import (
+	"context"
+	"errors"
+	config "itemService/config"
+	constants "itemService/constants"
+	customErr "itemService/errors"
+	metrics "itemService/metrics"
+	pb "itemService/proto"
+	"itemService/tracing"
+	"sync"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+// ErrCircuitOpen is returned by a Breaker that is not letting requests through to its ItemCatalog.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// BreakerState is the state of a Breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed lets all requests through.
+	BreakerClosed BreakerState = iota
+	// BreakerHalfOpen lets a limited number of trial requests through.
+	BreakerHalfOpen
+	// BreakerOpen rejects all requests.
+	BreakerOpen
+)
+
+// result is the outcome of a request let through by a Breaker.
+type result int
+
+const (
+	// resultSuccess means the catalog handled the request.
+	resultSuccess result = iota
+	// resultFailure means the catalog is unhealthy.
+	resultFailure
+	// resultIgnored means the request says nothing about the catalog's health,
+	// e.g. because the caller abandoned it.
+	resultIgnored
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerHalfOpen:
+		return "half-open"
+	case BreakerOpen:
+		return "open"
+	}
+	return "unknown"
+}
+
+// Breaker is a circuit breaker around an ItemCatalog. It implements ItemCatalog.
+// The breaker opens after a number of consecutive failures and rejects requests with ErrCircuitOpen.
+// After a timeout, it lets trial requests through and closes again once enough of them succeed.
+// Errors for the item itself, such as an ItemError, do not count as failures.
+type Breaker struct {
+	name    string
+	catalog ItemCatalog
+	config  *config.BreakerConfig
+	logger  *zap.Logger
+	now     func() time.Time
+
+	mu    sync.Mutex
+	state BreakerState
+	// generation is incremented on every state change, so that results of requests
+	// let through in an earlier state are ignored
+	generation uint64
+	// failures is the number of consecutive failures while closed
+	failures int
+	// successes is the number of consecutive successful trial requests while half-open
+	successes int
+	// trials is the number of trial requests in flight while half-open
+	trials   int
+	openedAt time.Time
+}
+
+// NewBreaker returns a closed Breaker around the catalog. The name labels the breaker's metrics and span tags.
+func NewBreaker(name string, catalog ItemCatalog, config *config.BreakerConfig, logger *zap.Logger) *Breaker {
+	metrics.ExternalCircuitBreakerState.WithLabelValues(name).Set(float64(BreakerClosed))
+	return &Breaker{
+		name:    name,
+		catalog: catalog,
+		config:  config,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.checkOpenTimeout()
+	return b.state
+}
+
+// FetchItem fetches the item from the catalog if the breaker lets the request through.
+// Else, it returns an error wrapping ErrCircuitOpen.
+func (b *Breaker) FetchItem(ctx context.Context, itemID int64, shopID int64) (*pb.Item, error) {
+	state, generation, ok := b.allow()
+
+	if span := ot.SpanFromContext(ctx); span != nil {
+		span.SetTag(tracing.BreakerName, b.name)
+		span.SetTag(tracing.BreakerState, state.String())
+	}
+
+	if !ok {
+		return nil, &customErr.Error{
+			ErrorCode: constants.ErrorExternalCircuitOpen,
+			ErrorMsg:  constants.ErrorExternalCircuitOpenMsg,
+			Err:       ErrCircuitOpen,
+		}
+	}
+
+	item, err := b.catalog.FetchItem(ctx, itemID, shopID)
+	b.record(generation, b.result(ctx, err))
+	return item, err
+}
+
+// allow reports whether a request may go through, along with the state and generation it was let through in.
+func (b *Breaker) allow() (BreakerState, uint64, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.checkOpenTimeout()
+	switch b.state {
+	case BreakerOpen:
+		return b.state, b.generation, false
+	case BreakerHalfOpen:
+		if b.trials >= b.config.HalfOpenMaxRequests {
+			return b.state, b.generation, false
+		}
+		b.trials++
+	}
+	return b.state, b.generation, true
+}
+
+// record updates the breaker with the result of a request let through in the given generation.
+func (b *Breaker) record(generation uint64, res result) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if generation != b.generation {
+		// the state changed while the request was in flight
+		return
+	}
+
+	switch b.state {
+	case BreakerClosed:
+		switch res {
+		case resultSuccess:
+			b.failures = 0
+			return
+		case resultIgnored:
+			return
+		}
+		b.failures++
+		if b.failures >= b.config.FailureThreshold {
+			b.setState(BreakerOpen)
+		}
+	case BreakerHalfOpen:
+		// release the trial, so that an ignored request lets another one through
+		b.trials--
+		switch res {
+		case resultFailure:
+			b.setState(BreakerOpen)
+			return
+		case resultIgnored:
+			return
+		}
+		b.successes++
+		if b.successes >= b.config.SuccessThreshold {
+			b.setState(BreakerClosed)
+		}
+	}
+}
+
+// checkOpenTimeout moves an open breaker to half-open once the open timeout has passed.
+// The caller must hold the lock.
+func (b *Breaker) checkOpenTimeout() {
+	openTimeout := time.Duration(b.config.OpenTimeout) * time.Millisecond
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= openTimeout {
+		b.setState(BreakerHalfOpen)
+	}
+}
+
+// setState moves the breaker to the given state and resets its counters.
+// The caller must hold the lock.
+func (b *Breaker) setState(state BreakerState) {
+	b.logger.Info(
+		constants.InfoCircuitBreakerStateChange,
+		zap.String(constants.Breaker, b.name),
+		zap.String(constants.From, b.state.String()),
+		zap.String(constants.To, state.String()),
+	)
+
+	b.state = state
+	b.generation++
+	b.failures = 0
+	b.successes = 0
+	b.trials = 0
+	if state == BreakerOpen {
+		b.openedAt = b.now()
+	}
+	metrics.ExternalCircuitBreakerState.WithLabelValues(b.name).Set(float64(state))
+}
+
+// result classifies the outcome of a request to the catalog.
+// Errors for the item itself are successes, since the catalog responded.
+// Requests abandoned by the caller are ignored, since they neither prove nor disprove the catalog's health.
+func (b *Breaker) result(ctx context.Context, err error) result {
+	if ctx.Err() != nil {
+		return resultIgnored
+	}
+	if err == nil {
+		return resultSuccess
+	}
+	var itemErr *ItemError
+	if errors.As(err, &itemErr) {
+		return resultSuccess
+	}
+	return resultFailure
+}
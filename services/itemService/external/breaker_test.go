@@ -0,0 +1,131 @@
+package external
+
+import (
+	"context"
+	"errors"
+	config "itemService/config"
+	pb "itemService/proto"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// fakeCatalog returns err from FetchItem, or an item if err is nil.
+type fakeCatalog struct {
+	err   error
+	calls int
+}
+
+func (c *fakeCatalog) FetchItem(ctx context.Context, itemID int64, shopID int64) (*pb.Item, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &pb.Item{ItemID: itemID, ShopID: shopID}, nil
+}
+
+func newTestBreaker(catalog ItemCatalog, now *time.Time) *Breaker {
+	cfg := &config.BreakerConfig{
+		FailureThreshold:    3,
+		OpenTimeout:         1000,
+		HalfOpenMaxRequests: 1,
+		SuccessThreshold:    2,
+	}
+	b := NewBreaker("test", catalog, cfg, zap.NewNop())
+	b.now = func() time.Time { return *now }
+	return b
+}
+
+func TestBreaker(t *testing.T) {
+	now := time.Now()
+	catalog := &fakeCatalog{err: errors.New("connection refused")}
+	b := newTestBreaker(catalog, &now)
+	ctx := context.Background()
+
+	// consecutive failures open the breaker
+	for i := 0; i < 3; i++ {
+		b.FetchItem(ctx, 1, 1)
+	}
+	if s := b.State(); s != BreakerOpen {
+		t.Fatalf("after failures: got state %s, want open", s)
+	}
+
+	// requests are rejected without calling the catalog while open
+	_, err := b.FetchItem(ctx, 1, 1)
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("while open: got error %v, want ErrCircuitOpen", err)
+	}
+	if catalog.calls != 3 {
+		t.Errorf("while open: got %d catalog calls, want 3", catalog.calls)
+	}
+
+	// a failed trial request opens the breaker again
+	now = now.Add(time.Second)
+	if s := b.State(); s != BreakerHalfOpen {
+		t.Fatalf("after open timeout: got state %s, want half-open", s)
+	}
+	b.FetchItem(ctx, 1, 1)
+	if s := b.State(); s != BreakerOpen {
+		t.Fatalf("after failed trial: got state %s, want open", s)
+	}
+
+	// enough successful trial requests close the breaker
+	now = now.Add(time.Second)
+	catalog.err = nil
+	for i := 0; i < 2; i++ {
+		if _, err := b.FetchItem(ctx, 1, 1); err != nil {
+			t.Fatalf("trial request: unexpected error %v", err)
+		}
+	}
+	if s := b.State(); s != BreakerClosed {
+		t.Fatalf("after successful trials: got state %s, want closed", s)
+	}
+}
+
+func TestBreakerIgnoresItemErrors(t *testing.T) {
+	now := time.Now()
+	catalog := &fakeCatalog{err: &ItemError{Code: 4, Msg: "item not found"}}
+	b := newTestBreaker(catalog, &now)
+
+	for i := 0; i < 5; i++ {
+		b.FetchItem(context.Background(), 1, 1)
+	}
+	if s := b.State(); s != BreakerClosed {
+		t.Errorf("after item errors: got state %s, want closed", s)
+	}
+}
+
+func TestBreakerIgnoresAbandonedTrials(t *testing.T) {
+	now := time.Now()
+	catalog := &fakeCatalog{err: errors.New("connection refused")}
+	b := newTestBreaker(catalog, &now)
+	for i := 0; i < 3; i++ {
+		b.FetchItem(context.Background(), 1, 1)
+	}
+	now = now.Add(time.Second)
+
+	// a trial abandoned by its caller neither closes nor opens the breaker
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	catalog.err = nil
+	b.FetchItem(ctx, 1, 1)
+	if s := b.State(); s != BreakerHalfOpen {
+		t.Fatalf("after abandoned trial: got state %s, want half-open", s)
+	}
+
+	// the abandoned trial is released without counting as a success,
+	// so it still takes two successful trials to close the breaker
+	if _, err := b.FetchItem(context.Background(), 1, 1); err != nil {
+		t.Fatalf("trial request: unexpected error %v", err)
+	}
+	if s := b.State(); s != BreakerHalfOpen {
+		t.Fatalf("after one successful trial: got state %s, want half-open", s)
+	}
+	if _, err := b.FetchItem(context.Background(), 1, 1); err != nil {
+		t.Fatalf("trial request: unexpected error %v", err)
+	}
+	if s := b.State(); s != BreakerClosed {
+		t.Errorf("after successful trials: got state %s, want closed", s)
+	}
+}
@@ -0,0 +1,24 @@
+package external
+
+import (
+	"context"
+	"fmt"
+	pb "itemService/proto"
+)
+
+// ItemCatalog is implemented by external providers of item information, such as the Shopee API.
+type ItemCatalog interface {
+	// FetchItem returns the information of the item identified by its itemID and shopID.
+	FetchItem(ctx context.Context, itemID int64, shopID int64) (*pb.Item, error)
+}
+
+// ItemError is returned by an ItemCatalog when the provider responds with an error for the item itself,
+// e.g. because the item does not exist. The provider is healthy when it returns an ItemError.
+type ItemError struct {
+	Code int
+	Msg  string
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("item error %d: %s", e.Code, e.Msg)
+}
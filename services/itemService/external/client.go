@@ -0,0 +1,116 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"io"
+	config "itemService/config"
+	constants "itemService/constants"
+	metrics "itemService/metrics"
+	"itemService/tracing"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const (
+	contentType     = "Content-Type"
+	contentTypeJSON = "application/json"
+)
+
+// HTTPClient is used for HTTP calls to external services.
+// Requests are bound to the caller's context and carry the caller's trace headers.
+// The status code and size of each response are recorded in the external request metrics.
+type HTTPClient struct {
+	client *http.Client
+	logger *zap.Logger
+}
+
+// NewHTTPClient returns a HTTPClient with the timeouts and connection pool limits set in the config.
+func NewHTTPClient(cfg *config.HTTPClientConfig, logger *zap.Logger) *HTTPClient {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   time.Duration(cfg.DialTimeout) * time.Millisecond,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout:   time.Duration(cfg.TLSHandshakeTimeout) * time.Millisecond,
+		ResponseHeaderTimeout: time.Duration(cfg.ResponseHeaderTimeout) * time.Millisecond,
+		IdleConnTimeout:       time.Duration(cfg.IdleConnTimeout) * time.Millisecond,
+		MaxIdleConns:          cfg.MaxIdleConns,
+		MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       cfg.MaxConnsPerHost,
+		ForceAttemptHTTP2:     true,
+	}
+
+	return &HTTPClient{
+		client: &http.Client{
+			Timeout:   time.Duration(cfg.Timeout) * time.Millisecond,
+			Transport: transport,
+		},
+		logger: logger,
+	}
+}
+
+// Get sends a GET request to the url and returns the response body and status code.
+// The span in ctx, if any, is injected into the request headers.
+// endpoint is the url without its query, used to label the metrics.
+func (c *HTTPClient) Get(ctx context.Context, url string, endpoint string) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	return c.do(ctx, req, url, endpoint)
+}
+
+// Post sends a POST request with the JSON body to the url and returns the response body and status code.
+// The span in ctx, if any, is injected into the request headers.
+// endpoint is the url without its query, used to label the metrics.
+func (c *HTTPClient) Post(ctx context.Context, url string, endpoint string, body []byte) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set(contentType, contentTypeJSON)
+	return c.do(ctx, req, url, endpoint)
+}
+
+// do sends the request and records the response in the external request metrics.
+func (c *HTTPClient) do(ctx context.Context, req *http.Request, url string, endpoint string) ([]byte, int, error) {
+	var err error
+
+	// propagate the trace to the external service
+	span := ot.SpanFromContext(ctx)
+	if span != nil {
+		span.SetTag(tracing.HTTPMethod, req.Method)
+		span.SetTag(tracing.HTTPURL, url)
+		err = span.Tracer().Inject(span.Context(), ot.HTTPHeaders, ot.HTTPHeadersCarrier(req.Header))
+		if err != nil {
+			c.logger.Warn(
+				constants.ErrorTraceInjectMsg,
+				zap.String(constants.Endpoint, url),
+				zap.Error(err),
+			)
+		}
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer res.Body.Close()
+
+	if span != nil {
+		span.SetTag(tracing.HTTPStatusCode, res.StatusCode)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	metrics.ExternalResponseStatus.WithLabelValues(endpoint, strconv.Itoa(res.StatusCode)).Inc()
+	metrics.ExternalResponseSize.WithLabelValues(endpoint).Observe(float64(len(body)))
+
+	return body, res.StatusCode, err
+}
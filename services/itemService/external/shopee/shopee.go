@@ -4,14 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	config "itemService/config"
 	constants "itemService/constants"
 	errors "itemService/errors"
+	"itemService/external"
 	metrics "itemService/metrics"
+	pb "itemService/proto"
 	"itemService/tracing"
+	"math"
+	"math/rand"
 	"net/http"
 	"strconv"
+	"time"
 
 	ot "github.com/opentracing/opentracing-go"
 
@@ -20,74 +24,215 @@ import (
 )
 
 const (
-	fetchItemPrice = "external.FetchItemPrice"
+	fetchItemPrice        = "external.FetchItemPrice"
+	fetchItemPriceAttempt = "external.FetchItemPrice.attempt"
 )
 
+// reasons for retrying a call, used to label the retry metric
+const (
+	retryReasonTransport   = "transport"
+	retryReasonStatus      = "status"
+	retryReasonShopeeError = "shopeeError"
+)
+
+// Client makes calls to the Shopee API. It implements external.ItemCatalog.
+type Client struct {
+	config     *config.Shopee
+	httpClient *external.HTTPClient
+	logger     *zap.Logger
+}
+
+// NewClient returns a Client for the Shopee API endpoints in the given config.
+func NewClient(config *config.Shopee, logger *zap.Logger) *Client {
+	return &Client{
+		config:     config,
+		httpClient: external.NewHTTPClient(&config.HTTP, logger),
+		logger:     logger,
+	}
+}
+
+// FetchItem fetches the item's information from the Shopee API.
+// It returns an error if the call fails or if the Shopee API returns an error for the item.
+func (c *Client) FetchItem(ctx context.Context, itemID int64, shopID int64) (*pb.Item, error) {
+	res, err := c.FetchItemPrice(ctx, itemID, shopID)
+	if err != nil {
+		// external api call error
+		return nil, err
+	}
+
+	if res.Error != 0 {
+		// shopee api returned error
+		c.logger.Error(
+			constants.ErrorExternalShopeeAPICallMsg,
+			zap.Int64(constants.ItemID, itemID),
+			zap.Int64(constants.ShopID, shopID),
+			zap.Any(constants.Res, res),
+		)
+		return nil, &errors.Error{
+			ErrorCode: constants.ErrorExternalShopeeAPICall,
+			ErrorMsg:  constants.ErrorExternalShopeeAPICallMsg,
+			Err:       &external.ItemError{Code: res.Error, Msg: res.ErrorMsg},
+		}
+	}
+
+	return &pb.Item{
+		ItemID: res.ItemData.ItemID,
+		ShopID: res.ItemData.ShopID,
+		Name:   res.ItemData.Name,
+		Price:  res.ItemData.Price,
+	}, nil
+}
+
 // FetchItemPrice makes a call to the Shopee API to fetch an item's information, including its name, price, image, rating etc.
 // It takes in an itemID and a shopID
-func FetchItemPrice(ctx context.Context, config *config.Shopee, logger *zap.Logger, itemID int64, shopID int64) (*GetItemRes, error) {
+// Failed calls are retried with exponential backoff according to the retry policy in the config.
+func (c *Client) FetchItemPrice(ctx context.Context, itemID int64, shopID int64) (*GetItemRes, error) {
 	// start tracing span from context
-	// ignore outgoing context
-	span, _ := ot.StartSpanFromContext(ctx, fetchItemPrice)
+	// the outgoing context carries the span into the attempts
+	span, ctx := ot.StartSpanFromContext(ctx, fetchItemPrice)
 	// add span tags
 	span.SetTag(tracing.SpanKind, tracing.SpanKindClient)
 	span.SetTag(tracing.Component, tracing.ComponentHTTP)
 	defer span.Finish()
+
+	maxAttempts := c.config.Retry.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	var res *GetItemRes
+	var err error
+	for attempt := 1; ; attempt++ {
+		var reason string
+		res, reason, err = c.fetchItemPriceAttempt(ctx, itemID, shopID, attempt)
+		if reason == "" || attempt == maxAttempts {
+			span.SetTag(tracing.RetryAttempt, attempt)
+			return res, err
+		}
+
+		backoff := c.backoff(attempt)
+		metrics.ExternalRequestRetries.WithLabelValues(c.config.GetItem.Endpoint, reason).Inc()
+		c.logger.Info(
+			constants.InfoExternalAPIRetry,
+			zap.Int64(constants.ItemID, itemID),
+			zap.Int64(constants.ShopID, shopID),
+			zap.Int(constants.Attempt, attempt),
+			zap.String(constants.Reason, reason),
+			zap.Duration(constants.Backoff, backoff),
+		)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			// caller gave up, return the last attempt's result
+			span.SetTag(tracing.RetryAttempt, attempt)
+			return res, err
+		}
+	}
+}
+
+// fetchItemPriceAttempt makes a single call to the Shopee API.
+// If the call can be retried, it returns the reason for retrying along with the call's result.
+func (c *Client) fetchItemPriceAttempt(ctx context.Context, itemID int64, shopID int64, attempt int) (*GetItemRes, string, error) {
+	// each attempt gets its own child span
+	span, ctx := ot.StartSpanFromContext(ctx, fetchItemPriceAttempt)
+	span.SetTag(tracing.SpanKind, tracing.SpanKindClient)
+	span.SetTag(tracing.Component, tracing.ComponentHTTP)
+	span.SetTag(tracing.RetryAttempt, attempt)
+	defer span.Finish()
 	// time the request
 	successStr := constants.True // for the metric label "success"
 	var errorCodeStr string      // for the metric label "errorCode"
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
-		metrics.ExternalRequestDuration.WithLabelValues(config.GetItem.Endpoint, successStr, errorCodeStr).Observe(v)
+		metrics.ExternalRequestDuration.WithLabelValues(c.config.GetItem.Endpoint, successStr, errorCodeStr).Observe(v)
 	}))
 	defer func() {
 		timer.ObserveDuration()
 	}()
 
 	// make external api call
-	endpoint := fmt.Sprintf("%s?itemID=%d&shopID=%d", config.GetItem.Endpoint, itemID, shopID)
+	endpoint := fmt.Sprintf("%s?itemID=%d&shopID=%d", c.config.GetItem.Endpoint, itemID, shopID)
 	span.SetTag(tracing.PeerAddress, endpoint)
-	raw, err := http.Get(endpoint)
+	body, statusCode, err := c.httpClient.Get(ctx, endpoint, c.config.GetItem.Endpoint)
 	if err != nil {
 		// error occured when making get request
-		logger.Error(
+		c.logger.Error(
 			constants.ErrorExternalShopeeAPICallMsg,
 			zap.String(constants.Endpoint, endpoint),
 			zap.Error(err),
 		)
 		successStr = constants.False
-		return nil, errors.Error{constants.ErrorExternalShopeeAPICall, constants.ErrorExternalShopeeAPICallMsg, err}
+		var reason string
+		if ctx.Err() == nil {
+			// the request failed on its own, not because the caller gave up
+			reason = retryReasonTransport
+			span.SetTag(tracing.RetryReason, reason)
+		}
+		return nil, reason, &errors.Error{ErrorCode: constants.ErrorExternalShopeeAPICall, ErrorMsg: constants.ErrorExternalShopeeAPICallMsg, Err: err}
 	}
-	defer raw.Body.Close()
 
-	body, err := io.ReadAll(raw.Body)
-	if err != nil {
-		// io error occured
-		logger.Error(
+	if statusCode != http.StatusOK {
+		// shopee api did not respond with a successful status
+		err = fmt.Errorf("unexpected status code %d", statusCode)
+		c.logger.Error(
 			constants.ErrorExternalShopeeAPICallMsg,
 			zap.String(constants.Endpoint, endpoint),
+			zap.Int(constants.StatusCode, statusCode),
 			zap.Error(err),
 		)
-		return nil, err
+		successStr = constants.False
+		var reason string
+		if contains(c.config.Retry.RetryableStatuses, statusCode) {
+			reason = retryReasonStatus
+			span.SetTag(tracing.RetryReason, reason)
+		}
+		return nil, reason, &errors.Error{ErrorCode: constants.ErrorExternalShopeeAPICall, ErrorMsg: constants.ErrorExternalShopeeAPICallMsg, Err: err}
 	}
 
-	var res *GetItemRes
+	var res GetItemRes
 	err = json.Unmarshal(body, &res)
 	if err != nil {
 		// unmarshalling error occured
-		logger.Error(
+		c.logger.Error(
 			constants.ErrorExternalShopeeAPICallMsg,
 			zap.String(constants.Endpoint, endpoint),
 			zap.Error(err),
 		)
 		successStr = constants.False
-		return nil, err
+		return nil, "", &errors.Error{ErrorCode: constants.ErrorExternalShopeeAPICall, ErrorMsg: constants.ErrorExternalShopeeAPICallMsg, Err: err}
 	}
 
 	errorCodeStr = strconv.Itoa(res.Error)
-	logger.Info(
+	c.logger.Info(
 		constants.InfoExternalAPICall,
 		zap.String(constants.Endpoint, endpoint),
 		zap.Any(constants.Res, res),
 	)
-	return res, nil
+	var reason string
+	if res.Error != 0 && contains(c.config.Retry.RetryableErrorCodes, res.Error) {
+		reason = retryReasonShopeeError
+		span.SetTag(tracing.RetryReason, reason)
+	}
+	return &res, reason, nil
+}
+
+// backoff returns how long to wait after the given attempt before retrying.
+// The backoff doubles with each attempt up to the max backoff, and is shortened by a random jitter
+// so that concurrent requests do not retry in lockstep.
+func (c *Client) backoff(attempt int) time.Duration {
+	backoff := float64(c.config.Retry.BaseBackoff) * math.Pow(2, float64(attempt-1))
+	if maxBackoff := float64(c.config.Retry.MaxBackoff); maxBackoff > 0 && backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	backoff -= backoff * c.config.Retry.Jitter * rand.Float64()
+	return time.Duration(backoff * float64(time.Millisecond))
+}
+
+func contains(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,153 @@
+package shopee
+
+import (
+	"context"
+	"itemService/config"
+	"itemService/constants"
+	customErr "itemService/errors"
+	"itemService/external/shopee/shopeetest"
+	metrics "itemService/metrics"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.uber.org/zap"
+)
+
+func newTestClient(server *shopeetest.Server) *Client {
+	cfg := &config.Shopee{
+		GetItem: config.API{Endpoint: server.GetItemEndpoint(), Method: "get"},
+		HTTP: config.HTTPClientConfig{
+			Timeout:               2000,
+			DialTimeout:           500,
+			ResponseHeaderTimeout: 1000,
+			MaxIdleConnsPerHost:   2,
+		},
+	}
+	return NewClient(cfg, zap.NewNop())
+}
+
+// observations returns the number of ExternalRequestDuration observations with the given labels.
+func observations(t *testing.T, endpoint string, success string, errorCode string) uint64 {
+	t.Helper()
+	var m dto.Metric
+	err := metrics.ExternalRequestDuration.WithLabelValues(endpoint, success, errorCode).(prometheus.Metric).Write(&m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestFetchItem(t *testing.T) {
+	server := shopeetest.NewServer(shopeetest.DefaultFixtures()...)
+	defer server.Close()
+	client := newTestClient(server)
+	endpoint := server.GetItemEndpoint()
+
+	item, err := client.FetchItem(context.Background(), 1001, 2001)
+	if err != nil {
+		t.Fatalf("FetchItem: unexpected error %v", err)
+	}
+	if item.ItemID != 1001 || item.ShopID != 2001 || item.Name != "Wireless Mouse" || item.Price != 1590000 {
+		t.Errorf("FetchItem: got %v", item)
+	}
+	if n := observations(t, endpoint, constants.True, "0"); n != 1 {
+		t.Errorf("ExternalRequestDuration{success=true, errorCode=0}: got %d observations, want 1", n)
+	}
+}
+
+func TestFetchItemErrors(t *testing.T) {
+	server := shopeetest.NewServer(shopeetest.DefaultFixtures()...)
+	defer server.Close()
+	client := newTestClient(server)
+	endpoint := server.GetItemEndpoint()
+
+	tests := []struct {
+		name           string
+		itemID, shopID int64
+		success        string
+		errorCode      string
+	}{
+		{name: "shopee error", itemID: 1004, shopID: 2002, success: constants.True, errorCode: "4"},
+		{name: "unknown item", itemID: 9999, shopID: 9999, success: constants.True, errorCode: "4"},
+		{name: "http status", itemID: 1006, shopID: 2003, success: constants.False, errorCode: ""},
+		{name: "malformed json", itemID: 1007, shopID: 2003, success: constants.False, errorCode: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := observations(t, endpoint, tt.success, tt.errorCode)
+
+			item, err := client.FetchItem(context.Background(), tt.itemID, tt.shopID)
+			if item != nil {
+				t.Errorf("FetchItem: got item %v, want nil", item)
+			}
+			v, ok := err.(*customErr.Error)
+			if !ok || v.ErrorCode != constants.ErrorExternalShopeeAPICall {
+				t.Errorf("FetchItem: got error %#v, want ErrorExternalShopeeAPICall", err)
+			}
+
+			if n := observations(t, endpoint, tt.success, tt.errorCode) - before; n != 1 {
+				t.Errorf("ExternalRequestDuration{success=%s, errorCode=%s}: got %d new observations, want 1", tt.success, tt.errorCode, n)
+			}
+		})
+	}
+}
+
+func TestFetchItemRetry(t *testing.T) {
+	tests := []struct {
+		name           string
+		itemID, shopID int64
+		wantRequests   int
+		wantErr        bool
+	}{
+		{name: "success", itemID: 1001, shopID: 2001, wantRequests: 1},
+		{name: "retryable status", itemID: 1006, shopID: 2003, wantRequests: 3, wantErr: true},
+		{name: "retryable shopee error", itemID: 1004, shopID: 2002, wantRequests: 3, wantErr: true},
+		{name: "malformed json", itemID: 1007, shopID: 2003, wantRequests: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := shopeetest.NewServer(shopeetest.DefaultFixtures()...)
+			defer server.Close()
+			client := newTestClient(server)
+			client.config.Retry = config.RetryConfig{
+				MaxAttempts:         3,
+				BaseBackoff:         1,
+				MaxBackoff:          2,
+				Jitter:              0.5,
+				RetryableErrorCodes: []int{shopeetest.ErrorItemNotFound},
+				RetryableStatuses:   []int{503},
+			}
+
+			_, err := client.FetchItem(context.Background(), tt.itemID, tt.shopID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FetchItem: got error %v, want error %v", err, tt.wantErr)
+			}
+			if n := server.Requests(); n != tt.wantRequests {
+				t.Errorf("FetchItem: got %d requests, want %d", n, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestFetchItemRetryCancelled(t *testing.T) {
+	server := shopeetest.NewServer(shopeetest.DefaultFixtures()...)
+	defer server.Close()
+	client := newTestClient(server)
+	client.config.Retry = config.RetryConfig{
+		MaxAttempts:       5,
+		BaseBackoff:       1000,
+		RetryableStatuses: []int{503},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.FetchItem(ctx, 1006, 2003)
+	if err == nil {
+		t.Fatal("FetchItem: got nil error")
+	}
+	if n := server.Requests(); n != 1 {
+		t.Errorf("FetchItem: got %d requests, want 1", n)
+	}
+}
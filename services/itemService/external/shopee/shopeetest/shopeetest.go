@@ -0,0 +1,188 @@
+// Package shopeetest provides a fake Shopee API server driven by fixtures, for tests and local development.
+package shopeetest
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// GetItemPath is the path the fake server serves the get item API on.
+const GetItemPath = "/api/v4/item/get"
+
+// ErrorItemNotFound is the Shopee error code returned for items without a fixture.
+const ErrorItemNotFound = 4
+
+//go:embed fixtures/items.json
+var defaultFixtures []byte
+
+// Fixture defines the fake server's response to a get item request for one item.
+type Fixture struct {
+	ItemID int64  `json:"itemid"`
+	ShopID int64  `json:"shopid"`
+	Name   string `json:"name"`
+	Price  int64  `json:"price"`
+	// Error is returned as the Shopee error code, e.g. for deleted items.
+	Error    int    `json:"error"`
+	ErrorMsg string `json:"error_msg"`
+	// StatusCode overrides the HTTP status code of the response if set.
+	StatusCode int `json:"statusCode"`
+	// LatencyMs delays the response by the given number of milliseconds.
+	LatencyMs int `json:"latencyMs"`
+	// Malformed makes the server respond with a body that is not valid JSON.
+	Malformed bool `json:"malformed"`
+}
+
+// getItemRes mirrors the response of the Shopee get item API.
+type getItemRes struct {
+	Error    int      `json:"error"`
+	ErrorMsg string   `json:"error_msg"`
+	Data     itemData `json:"data"`
+}
+
+type itemData struct {
+	Name   string `json:"name"`
+	Price  int64  `json:"price"`
+	ItemID int64  `json:"itemid"`
+	ShopID int64  `json:"shopid"`
+}
+
+// Handler serves the Shopee get item API from its fixtures. It is safe for concurrent use.
+type Handler struct {
+	mu       sync.RWMutex
+	fixtures map[string]Fixture
+	requests int64
+	// inFlight and maxInFlight count the requests being served, now and at most at once
+	inFlight    int64
+	maxInFlight int64
+}
+
+// NewHandler returns a Handler that serves the given fixtures.
+func NewHandler(fixtures ...Fixture) *Handler {
+	h := &Handler{
+		fixtures: make(map[string]Fixture),
+	}
+	for _, f := range fixtures {
+		h.SetFixture(f)
+	}
+	return h
+}
+
+// SetFixture adds or replaces the fixture for the fixture's item.
+func (h *Handler) SetFixture(f Fixture) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fixtures[fixtureKey(f.ItemID, f.ShopID)] = f
+}
+
+// Requests returns the number of requests served.
+func (h *Handler) Requests() int {
+	return int(atomic.LoadInt64(&h.requests))
+}
+
+// MaxInFlight returns the max number of requests served at the same time.
+func (h *Handler) MaxInFlight() int {
+	return int(atomic.LoadInt64(&h.maxInFlight))
+}
+
+// ServeHTTP responds to get item requests with the fixture for the requested itemID and shopID.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt64(&h.requests, 1)
+	inFlight := atomic.AddInt64(&h.inFlight, 1)
+	defer atomic.AddInt64(&h.inFlight, -1)
+	for {
+		max := atomic.LoadInt64(&h.maxInFlight)
+		if inFlight <= max || atomic.CompareAndSwapInt64(&h.maxInFlight, max, inFlight) {
+			break
+		}
+	}
+
+	if r.URL.Path != GetItemPath {
+		http.NotFound(w, r)
+		return
+	}
+
+	itemID, _ := strconv.ParseInt(r.URL.Query().Get("itemID"), 10, 64)
+	shopID, _ := strconv.ParseInt(r.URL.Query().Get("shopID"), 10, 64)
+
+	h.mu.RLock()
+	f, ok := h.fixtures[fixtureKey(itemID, shopID)]
+	h.mu.RUnlock()
+	if !ok {
+		f = Fixture{ItemID: itemID, ShopID: shopID, Error: ErrorItemNotFound}
+	}
+
+	if f.LatencyMs > 0 {
+		select {
+		case <-time.After(time.Duration(f.LatencyMs) * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if f.StatusCode != 0 {
+		w.WriteHeader(f.StatusCode)
+	}
+	if f.Malformed {
+		fmt.Fprint(w, `{"error": 0, "data": {`)
+		return
+	}
+
+	res := getItemRes{Error: f.Error, ErrorMsg: f.ErrorMsg}
+	if f.Error == 0 {
+		res.Data = itemData{Name: f.Name, Price: f.Price, ItemID: f.ItemID, ShopID: f.ShopID}
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// Server is a fake Shopee API server listening on a local port.
+type Server struct {
+	*httptest.Server
+	*Handler
+}
+
+// NewServer starts a Server that serves the given fixtures. The caller should call Close when finished.
+func NewServer(fixtures ...Fixture) *Server {
+	h := NewHandler(fixtures...)
+	return &Server{
+		Server:  httptest.NewServer(h),
+		Handler: h,
+	}
+}
+
+// GetItemEndpoint returns the URL of the server's get item API.
+func (s *Server) GetItemEndpoint() string {
+	return s.URL + GetItemPath
+}
+
+// DefaultFixtures returns the fixtures bundled in fixtures/items.json.
+func DefaultFixtures() []Fixture {
+	var fixtures []Fixture
+	if err := json.Unmarshal(defaultFixtures, &fixtures); err != nil {
+		panic(err)
+	}
+	return fixtures
+}
+
+// LoadFixtures reads a JSON array of fixtures from the file at path.
+func LoadFixtures(path string) ([]Fixture, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fixtures []Fixture
+	err = json.Unmarshal(bytes, &fixtures)
+	return fixtures, err
+}
+
+func fixtureKey(itemID int64, shopID int64) string {
+	return fmt.Sprintf("%d:%d", itemID, shopID)
+}
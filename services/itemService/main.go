@@ -4,6 +4,8 @@ import (
 	"itemService/config"
 	"itemService/constants"
 	"itemService/db"
+	"itemService/external"
+	"itemService/external/shopee"
 	jaegerTracer "itemService/tracing"
 	"log"
 
@@ -31,8 +33,8 @@ func main() {
 
 	logger.Info(constants.InfoConfigLoaded)
 
-	// connect to database, get database manager
-	dbManager, err := db.InitDatabase(&config.DbConfig, logger)
+	// get the repository for the configured database backend
+	repository, err := db.InitRepository(&config.DbConfig, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -42,6 +44,17 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	// keep hot items in process, in front of redis
+	cache := db.NewTieredCache(&config.RedisConfig, redisManager)
+
+	// create the client for the external item catalog
+	// wrapped in a circuit breaker so that a degraded provider fails fast
+	catalog := external.NewBreaker(
+		constants.Shopee,
+		shopee.NewClient(&config.ExternalConfig.Shopee, logger),
+		&config.ExternalConfig.Shopee.Breaker,
+		logger,
+	)
 
 	// init jaeger
 	tracer, closer, err := jaegerTracer.InitJaeger(&config.JaegerConfig, logger)
@@ -56,7 +69,7 @@ func main() {
 	server := server.Server{}
 
 	// start grpc server
-	server.StartServer(config, logger, dbManager, redisManager, tracer)
+	server.StartServer(config, logger, repository, cache, catalog, tracer)
 }
 
 func newLogger() (*zap.Logger, error) {
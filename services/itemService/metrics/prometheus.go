@@ -22,6 +22,26 @@ var (
 	RedisOpDuration *prometheus.HistogramVec
 	// ExternalRequestDuration tracks the time taken for external requests to complete
 	ExternalRequestDuration *prometheus.HistogramVec
+	// ExternalRequestRetries counts the retries of external requests
+	ExternalRequestRetries *prometheus.CounterVec
+	// ExternalCircuitBreakerState tracks the state of the circuit breakers around external dependencies
+	ExternalCircuitBreakerState *prometheus.GaugeVec
+	// ExternalResponseStatus counts the responses to external requests by status code
+	ExternalResponseStatus *prometheus.CounterVec
+	// ExternalResponseSize tracks the size of the responses to external requests
+	ExternalResponseSize *prometheus.HistogramVec
+	// PartialResponses counts the responses with some of their items missing
+	PartialResponses *prometheus.CounterVec
+	// CacheRequests counts cache lookups by tier and result
+	CacheRequests *prometheus.CounterVec
+	// CacheEvictions counts the entries evicted from a cache tier to make room for new entries
+	CacheEvictions *prometheus.CounterVec
+	// ItemRefreshes counts the items checked by the background refresher by result
+	ItemRefreshes *prometheus.CounterVec
+	// ItemFetchesCollapsed counts the item fetches that shared a fetch already in flight
+	ItemFetchesCollapsed prometheus.Counter
+	// AlertsSent counts the price-drop alerts delivered to users by notifier and result
+	AlertsSent *prometheus.CounterVec
 	// TotalGoRoutines tracks the number of running goroutines
 	TotalGoRoutines *prometheus.Gauge
 )
@@ -67,6 +87,86 @@ func init() {
 		[]string{"endpoint", "success", "errorCode"},
 	)
 
+	ExternalRequestRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_external_request_retries_total",
+			Help: "Counts the retries of external HTTP requests by the reason for retrying",
+		},
+		[]string{"endpoint", "reason"},
+	)
+
+	ExternalCircuitBreakerState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "external_circuit_breaker_state",
+			Help: "State of the circuit breaker around an external dependency, 0 for closed, 1 for half-open and 2 for open",
+		},
+		[]string{"breaker"},
+	)
+
+	ExternalResponseStatus = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_external_response_status_total",
+			Help: "Counts the responses to external HTTP requests by status code",
+		},
+		[]string{"endpoint", "statusCode"},
+	)
+
+	ExternalResponseSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_external_response_size_bytes",
+			Help:    "Measures the size of the responses to external HTTP requests in bytes",
+			Buckets: prometheus.ExponentialBuckets(256, 2, 8),
+		},
+		[]string{"endpoint"},
+	)
+
+	PartialResponses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_partial_responses_total",
+			Help: "Counts the responses returned with some of their items replaced by stubs with an error code",
+		},
+		[]string{"service", "method"},
+	)
+
+	CacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_requests_total",
+			Help: "Counts the cache lookups by tier and whether they were a hit or a miss",
+		},
+		[]string{"tier", "result"},
+	)
+
+	CacheEvictions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_evictions_total",
+			Help: "Counts the entries evicted from a cache tier to make room for new entries",
+		},
+		[]string{"tier"},
+	)
+
+	ItemRefreshes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "item_refreshes_total",
+			Help: "Counts the cached items checked by the background refresher, by whether they were refreshed, failed to refresh or skipped",
+		},
+		[]string{"result"},
+	)
+
+	ItemFetchesCollapsed = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "item_fetches_collapsed_total",
+			Help: "Counts the item fetches on cache misses that shared a fetch already in flight instead of calling the external API",
+		},
+	)
+
+	AlertsSent = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "alerts_sent_total",
+			Help: "Counts the price-drop alerts delivered to users, by notifier and whether they were sent or failed",
+		},
+		[]string{"notifier", "result"},
+	)
+
 	// TotalGoRoutines = prometheus.NewGauge(
 	// 	prometheus.GaugeOpts{
 	// 		Name: "process_itemservice_total_goroutines_count",
@@ -75,5 +175,5 @@ func init() {
 	// )
 
 	// register collectors
-	Reg.MustRegister(GrpcMetrics, RequestDuration, DatabaseOpDuration, RedisOpDuration, ExternalRequestDuration)
+	Reg.MustRegister(GrpcMetrics, RequestDuration, DatabaseOpDuration, RedisOpDuration, ExternalRequestDuration, ExternalRequestRetries, ExternalCircuitBreakerState, ExternalResponseStatus, ExternalResponseSize, PartialResponses, CacheRequests, CacheEvictions, ItemRefreshes, ItemFetchesCollapsed, AlertsSent)
 }
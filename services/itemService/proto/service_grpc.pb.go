@@ -24,7 +24,21 @@ const _ = grpc.SupportPackageIsVersion7
 type ItemServiceClient interface {
 	DeleteFav(ctx context.Context, in *DeleteFavReq, opts ...grpc.CallOption) (*DeleteFavRes, error)
 	AddFav(ctx context.Context, in *AddFavReq, opts ...grpc.CallOption) (*AddFavRes, error)
+	UpdateFav(ctx context.Context, in *UpdateFavReq, opts ...grpc.CallOption) (*UpdateFavRes, error)
+	BatchAddFav(ctx context.Context, in *BatchAddFavReq, opts ...grpc.CallOption) (*BatchAddFavRes, error)
+	BatchDeleteFav(ctx context.Context, in *BatchDeleteFavReq, opts ...grpc.CallOption) (*BatchDeleteFavRes, error)
+	DeleteAllFavourites(ctx context.Context, in *DeleteAllFavouritesReq, opts ...grpc.CallOption) (*DeleteAllFavouritesRes, error)
+	ExportFavourites(ctx context.Context, in *ExportFavouritesReq, opts ...grpc.CallOption) (ItemService_ExportFavouritesClient, error)
+	ImportFavourites(ctx context.Context, in *ImportFavouritesReq, opts ...grpc.CallOption) (*ImportFavouritesRes, error)
 	GetFavList(ctx context.Context, in *GetFavListReq, opts ...grpc.CallOption) (*GetFavListRes, error)
+	GetItemPriceHistory(ctx context.Context, in *GetItemPriceHistoryReq, opts ...grpc.CallOption) (*GetItemPriceHistoryRes, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionReq, opts ...grpc.CallOption) (*CreateCollectionRes, error)
+	ListCollections(ctx context.Context, in *ListCollectionsReq, opts ...grpc.CallOption) (*ListCollectionsRes, error)
+	RenameCollection(ctx context.Context, in *RenameCollectionReq, opts ...grpc.CallOption) (*RenameCollectionRes, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionReq, opts ...grpc.CallOption) (*DeleteCollectionRes, error)
+	MoveFavourite(ctx context.Context, in *MoveFavouriteReq, opts ...grpc.CallOption) (*MoveFavouriteRes, error)
+	AnnotateFav(ctx context.Context, in *AnnotateFavReq, opts ...grpc.CallOption) (*AnnotateFavRes, error)
+	ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsRes, error)
 }
 
 type itemServiceClient struct {
@@ -53,6 +67,83 @@ func (c *itemServiceClient) AddFav(ctx context.Context, in *AddFavReq, opts ...g
 	return out, nil
 }
 
+func (c *itemServiceClient) UpdateFav(ctx context.Context, in *UpdateFavReq, opts ...grpc.CallOption) (*UpdateFavRes, error) {
+	out := new(UpdateFavRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/UpdateFav", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) BatchAddFav(ctx context.Context, in *BatchAddFavReq, opts ...grpc.CallOption) (*BatchAddFavRes, error) {
+	out := new(BatchAddFavRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/BatchAddFav", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) BatchDeleteFav(ctx context.Context, in *BatchDeleteFavReq, opts ...grpc.CallOption) (*BatchDeleteFavRes, error) {
+	out := new(BatchDeleteFavRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/BatchDeleteFav", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) DeleteAllFavourites(ctx context.Context, in *DeleteAllFavouritesReq, opts ...grpc.CallOption) (*DeleteAllFavouritesRes, error) {
+	out := new(DeleteAllFavouritesRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/DeleteAllFavourites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) ExportFavourites(ctx context.Context, in *ExportFavouritesReq, opts ...grpc.CallOption) (ItemService_ExportFavouritesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ItemService_ServiceDesc.Streams[0], "/proto.ItemService/ExportFavourites", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &itemServiceExportFavouritesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ItemService_ExportFavouritesClient interface {
+	Recv() (*ExportFavouritesRes, error)
+	grpc.ClientStream
+}
+
+type itemServiceExportFavouritesClient struct {
+	grpc.ClientStream
+}
+
+func (x *itemServiceExportFavouritesClient) Recv() (*ExportFavouritesRes, error) {
+	m := new(ExportFavouritesRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *itemServiceClient) ImportFavourites(ctx context.Context, in *ImportFavouritesReq, opts ...grpc.CallOption) (*ImportFavouritesRes, error) {
+	out := new(ImportFavouritesRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/ImportFavourites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *itemServiceClient) GetFavList(ctx context.Context, in *GetFavListReq, opts ...grpc.CallOption) (*GetFavListRes, error) {
 	out := new(GetFavListRes)
 	err := c.cc.Invoke(ctx, "/proto.ItemService/GetFavList", in, out, opts...)
@@ -62,13 +153,99 @@ func (c *itemServiceClient) GetFavList(ctx context.Context, in *GetFavListReq, o
 	return out, nil
 }
 
+func (c *itemServiceClient) GetItemPriceHistory(ctx context.Context, in *GetItemPriceHistoryReq, opts ...grpc.CallOption) (*GetItemPriceHistoryRes, error) {
+	out := new(GetItemPriceHistoryRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/GetItemPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionReq, opts ...grpc.CallOption) (*CreateCollectionRes, error) {
+	out := new(CreateCollectionRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) ListCollections(ctx context.Context, in *ListCollectionsReq, opts ...grpc.CallOption) (*ListCollectionsRes, error) {
+	out := new(ListCollectionsRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/ListCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) RenameCollection(ctx context.Context, in *RenameCollectionReq, opts ...grpc.CallOption) (*RenameCollectionRes, error) {
+	out := new(RenameCollectionRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionReq, opts ...grpc.CallOption) (*DeleteCollectionRes, error) {
+	out := new(DeleteCollectionRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/DeleteCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) MoveFavourite(ctx context.Context, in *MoveFavouriteReq, opts ...grpc.CallOption) (*MoveFavouriteRes, error) {
+	out := new(MoveFavouriteRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/MoveFavourite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) AnnotateFav(ctx context.Context, in *AnnotateFavReq, opts ...grpc.CallOption) (*AnnotateFavRes, error) {
+	out := new(AnnotateFavRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/AnnotateFav", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsRes, error) {
+	out := new(ListTagsRes)
+	err := c.cc.Invoke(ctx, "/proto.ItemService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ItemServiceServer is the server API for ItemService service.
 // All implementations must embed UnimplementedItemServiceServer
 // for forward compatibility
 type ItemServiceServer interface {
 	DeleteFav(context.Context, *DeleteFavReq) (*DeleteFavRes, error)
 	AddFav(context.Context, *AddFavReq) (*AddFavRes, error)
+	UpdateFav(context.Context, *UpdateFavReq) (*UpdateFavRes, error)
+	BatchAddFav(context.Context, *BatchAddFavReq) (*BatchAddFavRes, error)
+	BatchDeleteFav(context.Context, *BatchDeleteFavReq) (*BatchDeleteFavRes, error)
+	DeleteAllFavourites(context.Context, *DeleteAllFavouritesReq) (*DeleteAllFavouritesRes, error)
+	ExportFavourites(*ExportFavouritesReq, ItemService_ExportFavouritesServer) error
+	ImportFavourites(context.Context, *ImportFavouritesReq) (*ImportFavouritesRes, error)
 	GetFavList(context.Context, *GetFavListReq) (*GetFavListRes, error)
+	GetItemPriceHistory(context.Context, *GetItemPriceHistoryReq) (*GetItemPriceHistoryRes, error)
+	CreateCollection(context.Context, *CreateCollectionReq) (*CreateCollectionRes, error)
+	ListCollections(context.Context, *ListCollectionsReq) (*ListCollectionsRes, error)
+	RenameCollection(context.Context, *RenameCollectionReq) (*RenameCollectionRes, error)
+	DeleteCollection(context.Context, *DeleteCollectionReq) (*DeleteCollectionRes, error)
+	MoveFavourite(context.Context, *MoveFavouriteReq) (*MoveFavouriteRes, error)
+	AnnotateFav(context.Context, *AnnotateFavReq) (*AnnotateFavRes, error)
+	ListTags(context.Context, *ListTagsReq) (*ListTagsRes, error)
 	mustEmbedUnimplementedItemServiceServer()
 }
 
@@ -82,9 +259,51 @@ func (UnimplementedItemServiceServer) DeleteFav(context.Context, *DeleteFavReq)
 func (UnimplementedItemServiceServer) AddFav(context.Context, *AddFavReq) (*AddFavRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFav not implemented")
 }
+func (UnimplementedItemServiceServer) UpdateFav(context.Context, *UpdateFavReq) (*UpdateFavRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFav not implemented")
+}
+func (UnimplementedItemServiceServer) BatchAddFav(context.Context, *BatchAddFavReq) (*BatchAddFavRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAddFav not implemented")
+}
+func (UnimplementedItemServiceServer) BatchDeleteFav(context.Context, *BatchDeleteFavReq) (*BatchDeleteFavRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteFav not implemented")
+}
+func (UnimplementedItemServiceServer) DeleteAllFavourites(context.Context, *DeleteAllFavouritesReq) (*DeleteAllFavouritesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAllFavourites not implemented")
+}
+func (UnimplementedItemServiceServer) ExportFavourites(*ExportFavouritesReq, ItemService_ExportFavouritesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportFavourites not implemented")
+}
+func (UnimplementedItemServiceServer) ImportFavourites(context.Context, *ImportFavouritesReq) (*ImportFavouritesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFavourites not implemented")
+}
 func (UnimplementedItemServiceServer) GetFavList(context.Context, *GetFavListReq) (*GetFavListRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFavList not implemented")
 }
+func (UnimplementedItemServiceServer) GetItemPriceHistory(context.Context, *GetItemPriceHistoryReq) (*GetItemPriceHistoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemPriceHistory not implemented")
+}
+func (UnimplementedItemServiceServer) CreateCollection(context.Context, *CreateCollectionReq) (*CreateCollectionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedItemServiceServer) ListCollections(context.Context, *ListCollectionsReq) (*ListCollectionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedItemServiceServer) RenameCollection(context.Context, *RenameCollectionReq) (*RenameCollectionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (UnimplementedItemServiceServer) DeleteCollection(context.Context, *DeleteCollectionReq) (*DeleteCollectionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedItemServiceServer) MoveFavourite(context.Context, *MoveFavouriteReq) (*MoveFavouriteRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFavourite not implemented")
+}
+func (UnimplementedItemServiceServer) AnnotateFav(context.Context, *AnnotateFavReq) (*AnnotateFavRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnotateFav not implemented")
+}
+func (UnimplementedItemServiceServer) ListTags(context.Context, *ListTagsReq) (*ListTagsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
 func (UnimplementedItemServiceServer) mustEmbedUnimplementedItemServiceServer() {}
 
 // UnsafeItemServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -134,6 +353,117 @@ func _ItemService_AddFav_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ItemService_UpdateFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFavReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).UpdateFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/UpdateFav",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).UpdateFav(ctx, req.(*UpdateFavReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_BatchAddFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAddFavReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).BatchAddFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/BatchAddFav",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).BatchAddFav(ctx, req.(*BatchAddFavReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_BatchDeleteFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteFavReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).BatchDeleteFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/BatchDeleteFav",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).BatchDeleteFav(ctx, req.(*BatchDeleteFavReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_DeleteAllFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAllFavouritesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).DeleteAllFavourites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/DeleteAllFavourites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).DeleteAllFavourites(ctx, req.(*DeleteAllFavouritesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_ExportFavourites_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportFavouritesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ItemServiceServer).ExportFavourites(m, &itemServiceExportFavouritesServer{stream})
+}
+
+type ItemService_ExportFavouritesServer interface {
+	Send(*ExportFavouritesRes) error
+	grpc.ServerStream
+}
+
+type itemServiceExportFavouritesServer struct {
+	grpc.ServerStream
+}
+
+func (x *itemServiceExportFavouritesServer) Send(m *ExportFavouritesRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ItemService_ImportFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFavouritesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).ImportFavourites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/ImportFavourites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).ImportFavourites(ctx, req.(*ImportFavouritesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ItemService_GetFavList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFavListReq)
 	if err := dec(in); err != nil {
@@ -152,6 +482,150 @@ func _ItemService_GetFavList_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ItemService_GetItemPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemPriceHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).GetItemPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/GetItemPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).GetItemPriceHistory(ctx, req.(*GetItemPriceHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/CreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).CreateCollection(ctx, req.(*CreateCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/ListCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).ListCollections(ctx, req.(*ListCollectionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/RenameCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).RenameCollection(ctx, req.(*RenameCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/DeleteCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_MoveFavourite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFavouriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).MoveFavourite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/MoveFavourite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).MoveFavourite(ctx, req.(*MoveFavouriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_AnnotateFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotateFavReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).AnnotateFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/AnnotateFav",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).AnnotateFav(ctx, req.(*AnnotateFavReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ItemService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).ListTags(ctx, req.(*ListTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ItemService_ServiceDesc is the grpc.ServiceDesc for ItemService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -167,11 +641,69 @@ var ItemService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddFav",
 			Handler:    _ItemService_AddFav_Handler,
 		},
+		{
+			MethodName: "UpdateFav",
+			Handler:    _ItemService_UpdateFav_Handler,
+		},
+		{
+			MethodName: "BatchAddFav",
+			Handler:    _ItemService_BatchAddFav_Handler,
+		},
+		{
+			MethodName: "BatchDeleteFav",
+			Handler:    _ItemService_BatchDeleteFav_Handler,
+		},
+		{
+			MethodName: "DeleteAllFavourites",
+			Handler:    _ItemService_DeleteAllFavourites_Handler,
+		},
+		{
+			MethodName: "ImportFavourites",
+			Handler:    _ItemService_ImportFavourites_Handler,
+		},
 		{
 			MethodName: "GetFavList",
 			Handler:    _ItemService_GetFavList_Handler,
 		},
+		{
+			MethodName: "GetItemPriceHistory",
+			Handler:    _ItemService_GetItemPriceHistory_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _ItemService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _ItemService_ListCollections_Handler,
+		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _ItemService_RenameCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _ItemService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "MoveFavourite",
+			Handler:    _ItemService_MoveFavourite_Handler,
+		},
+		{
+			MethodName: "AnnotateFav",
+			Handler:    _ItemService_AnnotateFav_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _ItemService_ListTags_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportFavourites",
+			Handler:       _ItemService_ExportFavourites_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/service.proto",
 }
@@ -1,43 +1,86 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	alerts "itemService/alerts"
 	config "itemService/config"
 	constants "itemService/constants"
 	db "itemService/db"
 	customErr "itemService/errors"
-	shopee "itemService/external/shopee"
+	export "itemService/export"
+	external "itemService/external"
+	metrics "itemService/metrics"
 	pb "itemService/proto"
+	"itemService/tracing"
 	util "itemService/util"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+	"unicode/utf8"
 
-	errGroup "golang.org/x/sync/errgroup"
+	ot "github.com/opentracing/opentracing-go"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
 
 	"go.uber.org/zap"
 )
 
+const (
+	// maxCollectionNameLength is the max number of characters in a collection name, as defined by the Collections table
+	maxCollectionNameLength = 64
+	// maxItemNameLength is the max number of characters in an item's name, as defined by the Favourites table
+	maxItemNameLength = 255
+	// maxNoteLength is the max number of characters in a favourite's note, as defined by the Favourites table
+	maxNoteLength = 500
+	// maxTagLength is the max number of characters in a tag, as defined by the FavouriteTags table
+	maxTagLength = 32
+	// maxTagsPerFavourite is the max number of tags on a favourite
+	maxTagsPerFavourite = 10
+)
+
 // Handler is a helper called by Server to handle various functions.
 // It implements the bulk of the business logic.
 type Handler struct {
 	config       *config.Config
-	dbManager    *db.DatabaseManager
-	redisManager *db.RedisManager
+	favourites   db.FavouritesRepository
+	collections  db.CollectionsRepository
+	priceHistory db.PriceHistoryRepository
+	cache        db.Cache
+	catalog      external.ItemCatalog
+	alerts       *alerts.Evaluator
 	logger       *zap.Logger
+	// fetches de-duplicates concurrent fetches of the same item
+	fetches singleflight.Group
+	// evaluations tracks the alert evaluations running in the background
+	evaluations sync.WaitGroup
 }
 
 // AddItemToUserFavList is called by the server when a request to the AddFav grpc service method is made
-func (h *Handler) AddItemToUserFavList(ctx context.Context, itemID int64, shopID int64, userID int64) (*pb.Item, error) {
+// The favourite's price-drop alert is set by targetPrice and dropPercent, which are 0 for no alert.
+func (h *Handler) AddItemToUserFavList(ctx context.Context, itemID int64, shopID int64, userID int64, targetPrice int64, dropPercent int32) (*pb.Item, error) {
+	err := validateAlertRule(targetPrice, dropPercent)
+	if err != nil {
+		return nil, err
+	}
+
 	// check if item is already in user's favourite's list
-	_, query, err := h.retrieveFavFromDb(ctx, userID, itemID, shopID)
+	_, err = h.retrieveFavFromDb(ctx, userID, itemID, shopID)
 
 	if err != nil {
 		if err != sql.ErrNoRows {
 			// other unexpected error occured
 			h.logger.Error(
 				constants.ErrorDatabaseQueryMsg,
-				zap.String(constants.Query, query),
+				zap.Int64(constants.UserID, userID),
+				zap.Int64(constants.ItemID, itemID),
+				zap.Int64(constants.ShopID, shopID),
 				zap.Error(err),
 			)
 			return nil, &customErr.Error{constants.ErrorDatabaseQuery, constants.ErrorDatabaseQueryMsg, err}
@@ -68,8 +111,9 @@ func (h *Handler) AddItemToUserFavList(ctx context.Context, itemID int64, shopID
 		return nil, err
 	}
 
-	// add favourite into database
-	err = h.addFavIntoDb(ctx, userID, itemID, shopID)
+	// add favourite into database, with the alert's base price set to the current price
+	rule := db.AlertRule{TargetPrice: targetPrice, DropPercent: dropPercent, BasePrice: item.Price}
+	err = h.addFavIntoDb(ctx, userID, itemID, shopID, db.ItemSnapshot{Name: item.Name, Price: item.Price}, rule)
 	if err != nil {
 		return nil, err
 	}
@@ -77,45 +121,690 @@ func (h *Handler) AddItemToUserFavList(ctx context.Context, itemID int64, shopID
 	return item, err
 }
 
-// GetUserFavourites is called by the server when a request to the GetFavList grpc service method is made
-func (h *Handler) GetUserFavourites(ctx context.Context, userID int64, page int32) ([]*pb.Item, int32, error) {
-	favourites, err := h.retrieveFavListFromDb(ctx, userID, int(page))
+// BatchAddItemsToUserFavList is called by the server when a request to the BatchAddFav grpc service method is made
+// The items are fetched concurrently, and the ones that could be fetched are added in a single transaction.
+// It returns the result for each item, or an error if the batch is invalid or the transaction failed, in which case no items are added.
+func (h *Handler) BatchAddItemsToUserFavList(ctx context.Context, userID int64, batch []*pb.BatchFavItem) ([]*pb.BatchFavResult, error) {
+	if len(batch) == 0 || len(batch) > h.config.MaxBatchSize {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorInvalidBatchSize, ErrorMsg: constants.ErrorInvalidBatchSizeMsg}
+	}
+
+	results := make([]*pb.BatchFavResult, len(batch))
+	var wg sync.WaitGroup
+	for i, req := range batch {
+		results[i] = &pb.BatchFavResult{ItemID: req.ItemID, ShopID: req.ShopID}
+		err := validateAlertRule(req.TargetPrice, req.DropPercent)
+		if err != nil {
+			results[i].ErrorCode = errorCode(err)
+			continue
+		}
+		wg.Add(1)
+		go func(result *pb.BatchFavResult) {
+			defer wg.Done()
+			// checks the cache for the item, else makes an external api call to fetch the item information
+			item, err := h.getItem(ctx, result.ItemID, result.ShopID)
+			if isItemUnavailable(err) {
+				result.Status = pb.BatchFavStatus_UNAVAILABLE
+				return
+			}
+			if err != nil {
+				result.ErrorCode = errorCode(err)
+				return
+			}
+			result.Item = item
+		}(results[i])
+	}
+	// wait
+	wg.Wait()
+
+	// add the fetched items, with the alerts' base prices set to the current prices
+	var favourites []db.NewFavourite
+	var added []*pb.BatchFavResult
+	for i, result := range results {
+		if result.Item == nil {
+			continue
+		}
+		favourites = append(favourites, db.NewFavourite{
+			ItemID:    result.ItemID,
+			ShopID:    result.ShopID,
+			Item:      db.ItemSnapshot{Name: result.Item.Name, Price: result.Item.Price},
+			AlertRule: db.AlertRule{TargetPrice: batch[i].TargetPrice, DropPercent: batch[i].DropPercent, BasePrice: result.Item.Price},
+		})
+		added = append(added, result)
+	}
+	if len(favourites) == 0 {
+		return results, nil
+	}
+
+	ids, err := h.favourites.BatchAdd(ctx, userID, favourites)
+	if err != nil {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorDatabaseInsert, ErrorMsg: constants.ErrorDatabaseInsertMsg, Err: err}
+	}
+	var count int
+	for i, result := range added {
+		if ids[i] == 0 {
+			result.Status = pb.BatchFavStatus_ALREADY_EXISTS
+			result.Item = nil
+			continue
+		}
+		result.Status = pb.BatchFavStatus_ADDED
+		count++
+	}
+	h.logger.Info(
+		constants.InfoFavouritesBatchAdded,
+		zap.Int64(constants.UserID, userID),
+		zap.Int(constants.Count, count),
+	)
+	return results, nil
+}
+
+// BatchDeleteUserFavourites is called by the server when a request to the BatchDeleteFav grpc service method is made
+// The items are deleted in a single transaction. It returns the result for each item,
+// or an error if the batch is invalid or the transaction failed, in which case no items are deleted.
+func (h *Handler) BatchDeleteUserFavourites(ctx context.Context, userID int64, batch []*pb.BatchFavItem) ([]*pb.BatchFavResult, error) {
+	if len(batch) == 0 || len(batch) > h.config.MaxBatchSize {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorInvalidBatchSize, ErrorMsg: constants.ErrorInvalidBatchSizeMsg}
+	}
+
+	items := make([]db.ItemKey, len(batch))
+	for i, req := range batch {
+		items[i] = db.ItemKey{ItemID: req.ItemID, ShopID: req.ShopID}
+	}
+	deleted, err := h.favourites.BatchDelete(ctx, userID, items)
+	if err != nil {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorDatabaseDelete, ErrorMsg: constants.ErrorDatabaseDeleteMsg, Err: err}
+	}
+
+	results := make([]*pb.BatchFavResult, len(batch))
+	var count int
+	for i, item := range items {
+		results[i] = &pb.BatchFavResult{ItemID: item.ItemID, ShopID: item.ShopID, Status: pb.BatchFavStatus_NOT_FOUND}
+		if deleted[i] {
+			results[i].Status = pb.BatchFavStatus_DELETED
+			count++
+		}
+	}
+	h.logger.Info(
+		constants.InfoFavouritesBatchDeleted,
+		zap.Int64(constants.UserID, userID),
+		zap.Int(constants.Count, count),
+	)
+	return results, nil
+}
+
+// DeleteAllUserFavourites is called by the server when a request to the DeleteAllFavourites grpc service method is made
+// It deletes all of the user's favourites and collections, and returns the number of favourites deleted.
+// Deleting the favourites of a user with none succeeds, so that the request can be retried safely.
+func (h *Handler) DeleteAllUserFavourites(ctx context.Context, userID int64) (int64, error) {
+	deleted, err := h.favourites.DeleteAll(ctx, userID)
+	if err != nil {
+		return 0, &customErr.Error{ErrorCode: constants.ErrorDatabaseDelete, ErrorMsg: constants.ErrorDatabaseDeleteMsg, Err: err}
+	}
+	if err := h.collections.DeleteAllCollections(ctx, userID); err != nil {
+		return deleted, &customErr.Error{ErrorCode: constants.ErrorDatabaseDelete, ErrorMsg: constants.ErrorDatabaseDeleteMsg, Err: err}
+	}
+	h.logger.Info(
+		constants.InfoFavouritesPurged,
+		zap.Int64(constants.UserID, userID),
+		zap.Int64(constants.Count, deleted),
+	)
+	return deleted, nil
+}
+
+// ExportUserFavourites is called by the server when a request to the ExportFavourites grpc service method is made
+// It writes all of the user's favourites in the format, the latest added first, and sends the file a page of favourites at a time.
+func (h *Handler) ExportUserFavourites(ctx context.Context, userID int64, format export.Format, send func(data []byte) error) error {
+	if !format.Valid() {
+		return &customErr.Error{ErrorCode: constants.ErrorInvalidFileFormat, ErrorMsg: constants.ErrorInvalidFileFormatMsg}
+	}
+
+	pageSize := h.config.MaxPageSize
+	if pageSize <= 0 {
+		pageSize = h.config.MaxPerPage
+	}
+
+	var buf bytes.Buffer
+	w := export.NewWriter(&buf, format)
+	var after *db.FavouriteCursor
+	var count int
+	for {
+		favourites, err := h.favourites.ListAfter(ctx, userID, db.FavouriteFilter{}, db.SortAddedDesc, after, pageSize)
+		if err != nil {
+			return &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg, Err: err}
+		}
+		for _, fav := range favourites {
+			err = w.Write(favouriteToRecord(fav))
+			if err != nil {
+				return &customErr.Error{ErrorCode: constants.ErrorMarshal, ErrorMsg: constants.ErrorExportMsg, Err: err}
+			}
+		}
+		count += len(favourites)
+		if len(favourites) < pageSize {
+			break
+		}
+		cursor := favourites[len(favourites)-1].Cursor()
+		after = &cursor
+
+		// send the page, the last page is sent with the end of the file
+		err = w.Flush()
+		if err != nil {
+			return &customErr.Error{ErrorCode: constants.ErrorMarshal, ErrorMsg: constants.ErrorExportMsg, Err: err}
+		}
+		err = send(buf.Bytes())
+		if err != nil {
+			return err
+		}
+		buf.Reset()
+	}
+
+	err := w.Close()
+	if err != nil {
+		return &customErr.Error{ErrorCode: constants.ErrorMarshal, ErrorMsg: constants.ErrorExportMsg, Err: err}
+	}
+	err = send(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	h.logger.Info(
+		constants.InfoFavouritesExported,
+		zap.Int64(constants.UserID, userID),
+		zap.Int(constants.Count, count),
+	)
+	return nil
+}
+
+// ImportUserFavourites is called by the server when a request to the ImportFavourites grpc service method is made
+// Records that fail validation are skipped, and records for items already in the user's favourites or repeated in the file are counted as duplicates.
+// The rest are added in a single transaction, keeping the time they were added, with the note, tags and alert rule in the file.
+// A percentage drop is measured from the item's current price, and dropped if the item cannot be fetched.
+func (h *Handler) ImportUserFavourites(ctx context.Context, userID int64, format export.Format, data []byte) (imported int32, duplicates int32, invalidRecords []int32, err error) {
+	if !format.Valid() {
+		return 0, 0, nil, &customErr.Error{ErrorCode: constants.ErrorInvalidFileFormat, ErrorMsg: constants.ErrorInvalidFileFormatMsg}
+	}
+	records, err := export.Read(bytes.NewReader(data), format, h.config.MaxImportSize)
+	if err == export.ErrTooManyRecords {
+		return 0, 0, nil, &customErr.Error{ErrorCode: constants.ErrorImportTooLarge, ErrorMsg: constants.ErrorImportTooLargeMsg}
+	}
+	if err != nil {
+		return 0, 0, nil, &customErr.Error{ErrorCode: constants.ErrorInvalidImportFile, ErrorMsg: constants.ErrorInvalidImportFileMsg, Err: err}
+	}
+
+	seen := make(map[db.ItemKey]bool, len(records))
+	var valid []export.Record
+	for i, record := range records {
+		record, err := validateRecord(record)
+		if err != nil {
+			invalidRecords = append(invalidRecords, int32(i+1))
+			continue
+		}
+		key := db.ItemKey{ItemID: record.ItemID, ShopID: record.ShopID}
+		if seen[key] {
+			duplicates++
+			continue
+		}
+		seen[key] = true
+		valid = append(valid, record)
+	}
+	if len(valid) == 0 {
+		return 0, duplicates, invalidRecords, nil
+	}
+
+	// add the earliest added first, so that the favourites keep their order
+	sort.SliceStable(valid, func(i, j int) bool {
+		return valid[i].TimeAdded.Before(valid[j].TimeAdded)
+	})
+	favourites := make([]db.NewFavourite, len(valid))
+	// the favourites with a percentage drop, whose base price is the item's current price
+	var dropping []db.Favourite
+	var droppingIndexes []int
+	for i, record := range valid {
+		favourites[i] = db.NewFavourite{
+			ItemID:    record.ItemID,
+			ShopID:    record.ShopID,
+			TimeAdded: record.TimeAdded,
+			Item:      db.ItemSnapshot{Name: record.Name, Price: record.Price},
+			Note:      record.Note,
+			Tags:      record.Tags,
+			AlertRule: db.AlertRule{TargetPrice: record.TargetPrice, DropPercent: record.DropPercent},
+		}
+		if record.DropPercent > 0 {
+			dropping = append(dropping, db.Favourite{ItemID: record.ItemID, ShopID: record.ShopID})
+			droppingIndexes = append(droppingIndexes, i)
+		}
+	}
+	// the current prices are retrieved a page at a time, so that a large import does not flood the external API
+	pageSize := h.config.MaxPageSize
+	if pageSize <= 0 {
+		pageSize = h.config.MaxPerPage
+	}
+	for start := 0; start < len(dropping); start += pageSize {
+		end := start + pageSize
+		if end > len(dropping) {
+			end = len(dropping)
+		}
+		items, _, err := h.retrieveFavItems(ctx, userID, dropping[start:end])
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		for j, i := range droppingIndexes[start:end] {
+			if items[j].ErrorCode != 0 || items[j].Unavailable {
+				// the drop cannot be measured without the current price
+				favourites[i].DropPercent = 0
+				continue
+			}
+			favourites[i].Item = db.ItemSnapshot{Name: items[j].Name, Price: items[j].Price}
+			favourites[i].BasePrice = items[j].Price
+		}
+	}
+	ids, err := h.favourites.BatchAdd(ctx, userID, favourites)
+	if err != nil {
+		return 0, 0, nil, &customErr.Error{ErrorCode: constants.ErrorDatabaseInsert, ErrorMsg: constants.ErrorDatabaseInsertMsg, Err: err}
+	}
+	for _, id := range ids {
+		if id == 0 {
+			duplicates++
+			continue
+		}
+		imported++
+	}
+	h.logger.Info(
+		constants.InfoFavouritesImported,
+		zap.Int64(constants.UserID, userID),
+		zap.Int32(constants.Count, imported),
+	)
+	return imported, duplicates, invalidRecords, nil
+}
+
+// UpdateUserFavAlert is called by the server when a request to the UpdateFav grpc service method is made
+// It replaces the favourite's price-drop alert. A percentage drop is measured from the item's current price.
+func (h *Handler) UpdateUserFavAlert(ctx context.Context, itemID int64, shopID int64, userID int64, targetPrice int64, dropPercent int32) error {
+	err := validateAlertRule(targetPrice, dropPercent)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.retrieveFavFromDb(ctx, userID, itemID, shopID)
+	if err == sql.ErrNoRows {
+		return &customErr.Error{ErrorCode: constants.ErrorItemNotInFavourites, ErrorMsg: constants.ErrorItemNotInFavouritesMsg}
+	}
+	if err != nil {
+		h.logger.Error(
+			constants.ErrorDatabaseQueryMsg,
+			zap.Int64(constants.UserID, userID),
+			zap.Int64(constants.ItemID, itemID),
+			zap.Int64(constants.ShopID, shopID),
+			zap.Error(err),
+		)
+		return &customErr.Error{constants.ErrorDatabaseQuery, constants.ErrorDatabaseQueryMsg, err}
+	}
+
+	rule := db.AlertRule{TargetPrice: targetPrice, DropPercent: dropPercent}
+	if dropPercent > 0 {
+		item, err := h.getItem(ctx, itemID, shopID)
+		if err != nil {
+			return err
+		}
+		rule.BasePrice = item.Price
+	}
+
+	err = h.favourites.UpdateAlertRule(ctx, userID, itemID, shopID, rule)
+	if err != nil {
+		return &customErr.Error{constants.ErrorDatabaseUpdate, constants.ErrorDatabaseUpdateMsg, err}
+	}
+	return nil
+}
+
+// GetUserFavourites is called by the server when a request to the GetFavList grpc service method is made with a page number
+// Items that could not be retrieved are returned as stubs with an item-level error code instead of failing the page.
+// partial reports whether any of the items are stubs for failures.
+// Only the favourites selected by the filter are listed, in the sort order.
+func (h *Handler) GetUserFavourites(ctx context.Context, userID int64, filter db.FavouriteFilter, sort db.FavouriteSort, page int32) (items []*pb.Item, totalPages int32, partial bool, err error) {
+	if page < 0 {
+		return nil, 0, false, &customErr.Error{ErrorCode: constants.ErrorInvalidPage, ErrorMsg: constants.ErrorInvalidPageMsg}
+	}
+	filter, err = h.validateFavouriteFilter(ctx, userID, filter, sort)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	favourites, err := h.retrieveFavListFromDb(ctx, userID, filter, sort, int(page))
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, false, err
 	}
 
-	// list of items to return to the user
-	var items = make([]*pb.Item, len(favourites))
+	items, partial, err = h.retrieveFavItems(ctx, userID, favourites)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	// get total pages
+	totalPages, err = h.getFavouritesCount(ctx, userID, filter)
+	if err != nil {
+		return items, 0, false, err
+	}
+
+	return items, totalPages, partial, err
+}
+
+// GetUserFavouritesAfter is called by the server when a request to the GetFavList grpc service method is made with a cursor or page size
+// It lists up to pageSize favourites after the cursor, or from the start if the cursor is empty, and returns the cursor to the next page.
+// The next cursor is empty when there are no more favourites. Favourites can only be listed by the time they were added this way.
+func (h *Handler) GetUserFavouritesAfter(ctx context.Context, userID int64, filter db.FavouriteFilter, sort db.FavouriteSort, cursor string, pageSize int32) (items []*pb.Item, nextCursor string, partial bool, err error) {
+	if sort != db.SortAddedDesc && sort != db.SortAddedAsc {
+		return nil, "", false, &customErr.Error{ErrorCode: constants.ErrorInvalidSort, ErrorMsg: constants.ErrorInvalidSortMsg}
+	}
+	if pageSize < 0 {
+		return nil, "", false, &customErr.Error{ErrorCode: constants.ErrorInvalidPageSize, ErrorMsg: constants.ErrorInvalidPageSizeMsg}
+	}
+	limit := int(pageSize)
+	if limit == 0 {
+		limit = h.config.MaxPerPage
+	}
+	if h.config.MaxPageSize > 0 && limit > h.config.MaxPageSize {
+		limit = h.config.MaxPageSize
+	}
+
+	var after *db.FavouriteCursor
+	if cursor != "" {
+		after, err = decodeCursor(cursor, sort)
+		if err != nil {
+			return nil, "", false, &customErr.Error{ErrorCode: constants.ErrorInvalidCursor, ErrorMsg: constants.ErrorInvalidCursorMsg, Err: err}
+		}
+	}
+	filter, err = h.validateFavouriteFilter(ctx, userID, filter, sort)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	// query one more favourite than the page holds to know whether there is a next page
+	favourites, err := h.favourites.ListAfter(ctx, userID, filter, sort, after, limit+1)
+	if err != nil {
+		h.logger.Error(
+			constants.ErrorDatabaseQueryMsg,
+			zap.Int64(constants.UserID, userID),
+			zap.String(constants.Cursor, cursor),
+			zap.Error(err),
+		)
+		return nil, "", false, &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg, Err: err}
+	}
+	if len(favourites) > limit {
+		favourites = favourites[:limit]
+		nextCursor = encodeCursor(favourites[limit-1].Cursor(), sort)
+	}
+
+	items, partial, err = h.retrieveFavItems(ctx, userID, favourites)
+	if err != nil {
+		return nil, "", false, err
+	}
+	return items, nextCursor, partial, nil
+}
+
+// validateFavouriteFilter is a helper function to validate the sort order and filter of a list of the user's favourites.
+// It returns the filter with its tag normalised.
+func (h *Handler) validateFavouriteFilter(ctx context.Context, userID int64, filter db.FavouriteFilter, sort db.FavouriteSort) (db.FavouriteFilter, error) {
+	if sort < db.SortAddedDesc || sort > db.SortNameDesc {
+		return filter, &customErr.Error{ErrorCode: constants.ErrorInvalidSort, ErrorMsg: constants.ErrorInvalidSortMsg}
+	}
+	if filter.MinPrice < 0 || filter.MaxPrice < 0 || filter.MaxPrice != 0 && filter.MinPrice > filter.MaxPrice {
+		return filter, &customErr.Error{ErrorCode: constants.ErrorInvalidPriceRange, ErrorMsg: constants.ErrorInvalidPriceRangeMsg}
+	}
+	if filter.CollectionID != 0 {
+		_, err := h.retrieveCollectionFromDb(ctx, userID, filter.CollectionID)
+		if err != nil {
+			return filter, err
+		}
+	}
+	filter.Tag = normalizeTag(filter.Tag)
+	return filter, nil
+}
+
+// retrieveFavItems is a helper function to retrieve the items of the user's favourites, from the cache or the external provider.
+// partial reports whether any of the items are stubs for failures.
+func (h *Handler) retrieveFavItems(ctx context.Context, userID int64, favourites []db.Favourite) (items []*pb.Item, partial bool, err error) {
+	// list of items to return to the user, with the items in the cache retrieved in one round trip
+	items, err = h.retrieveItemsFromRedis(ctx, favourites)
+	if err != nil {
+		return nil, false, err
+	}
+	for i, item := range items {
+		if item != nil && item.Unavailable {
+			items[i] = unavailableItem(item.ItemID, item.ShopID)
+		}
+	}
 
-	// fetch items concurrently
-	g := new(errGroup.Group)
+	// fetch items not in the cache concurrently
+	// items the external provider reports as missing are returned as placeholders
+	var wg sync.WaitGroup
+	var failed int32
 
 	for i, fav := range favourites {
-		fav := fav
-		items := items
-		i := i
-		g.Go(
-			func() error {
-				// metrics.TotalGoRoutines.Inc()
-				item, err := h.getItem(ctx, fav.ItemID, fav.ShopID)
-				items[i] = item
-				return err
-			})
-	}
-	// metrics.TotalGoRoutines.Dec()
+		if items[i] != nil {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, fav db.Favourite) {
+			defer wg.Done()
+			item, err := h.fetchItem(ctx, fav.ItemID, fav.ShopID)
+			if isItemUnavailable(err) {
+				items[i] = unavailableItem(fav.ItemID, fav.ShopID)
+				return
+			}
+			if err != nil {
+				h.logger.Error(
+					constants.ErrorGetItemMsg,
+					zap.Int64(constants.UserID, userID),
+					zap.Int64(constants.ItemID, fav.ItemID),
+					zap.Int64(constants.ShopID, fav.ShopID),
+					zap.Error(err),
+				)
+				atomic.AddInt32(&failed, 1)
+				items[i] = &pb.Item{ItemID: fav.ItemID, ShopID: fav.ShopID, ErrorCode: errorCode(err)}
+				return
+			}
+			items[i] = item
+		}(i, fav)
+	}
 	// wait
-	err = g.Wait()
+	wg.Wait()
+
+	// set the user's note and tags on copies of the items, as fetched items are shared with concurrent requests
+	for i, fav := range favourites {
+		if fav.Note == "" && len(fav.Tags) == 0 {
+			continue
+		}
+		item := proto.Clone(items[i]).(*pb.Item)
+		item.Note = fav.Note
+		item.Tags = fav.Tags
+		items[i] = item
+	}
+
+	return items, failed > 0, nil
+}
+
+// GetItemPriceHistory is called by the server when a request to the GetItemPriceHistory grpc service method is made
+// It returns the item's prices recorded between from and to, the oldest first.
+func (h *Handler) GetItemPriceHistory(ctx context.Context, itemID int64, shopID int64, from time.Time, to time.Time) ([]*pb.PricePoint, error) {
+	if from.After(to) {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorInvalidTimeRange, ErrorMsg: constants.ErrorInvalidTimeRangeMsg}
+	}
+
+	points, err := h.priceHistory.ListPricePoints(ctx, itemID, shopID, from, to)
 	if err != nil {
-		return items, 0, err
+		return nil, &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg, Err: err}
 	}
 
-	// get total pages
-	totalPages, err := h.getFavouritesCount(ctx, userID)
+	pbPoints := make([]*pb.PricePoint, len(points))
+	for i, point := range points {
+		pbPoints[i] = &pb.PricePoint{
+			Price: point.Price,
+			Time:  point.TimeRecorded.Unix(),
+		}
+	}
+	return pbPoints, nil
+}
+
+// CreateUserCollection is called by the server when a request to the CreateCollection grpc service method is made
+func (h *Handler) CreateUserCollection(ctx context.Context, userID int64, name string) (*pb.Collection, error) {
+	name, err := validateCollectionName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := h.collections.ListCollections(ctx, userID)
+	if err != nil {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg, Err: err}
+	}
+	if len(collections) >= h.config.MaxCollections {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorTooManyCollections, ErrorMsg: constants.ErrorTooManyCollectionsMsg}
+	}
+
+	collection, err := h.collections.CreateCollection(ctx, userID, name)
+	if err == db.ErrDuplicateCollection {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorCollectionExists, ErrorMsg: constants.ErrorCollectionExistsMsg}
+	}
+	if err != nil {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorDatabaseInsert, ErrorMsg: constants.ErrorDatabaseInsertMsg, Err: err}
+	}
+	h.logger.Info(
+		constants.InfoCollectionCreated,
+		zap.Int64(constants.UserID, userID),
+		zap.Int64(constants.CollectionID, collection.ID),
+		zap.String(constants.Name, collection.Name),
+	)
+	return collectionToProto(*collection), nil
+}
+
+// GetUserCollections is called by the server when a request to the ListCollections grpc service method is made
+func (h *Handler) GetUserCollections(ctx context.Context, userID int64) ([]*pb.Collection, error) {
+	collections, err := h.collections.ListCollections(ctx, userID)
+	if err != nil {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg, Err: err}
+	}
+
+	pbCollections := make([]*pb.Collection, len(collections))
+	for i, collection := range collections {
+		pbCollections[i] = collectionToProto(collection)
+	}
+	return pbCollections, nil
+}
+
+// RenameUserCollection is called by the server when a request to the RenameCollection grpc service method is made
+func (h *Handler) RenameUserCollection(ctx context.Context, userID int64, collectionID int64, name string) error {
+	name, err := validateCollectionName(name)
+	if err != nil {
+		return err
+	}
+	_, err = h.retrieveCollectionFromDb(ctx, userID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	err = h.collections.RenameCollection(ctx, userID, collectionID, name)
+	if err == db.ErrDuplicateCollection {
+		return &customErr.Error{ErrorCode: constants.ErrorCollectionExists, ErrorMsg: constants.ErrorCollectionExistsMsg}
+	}
+	if err != nil {
+		return &customErr.Error{ErrorCode: constants.ErrorDatabaseUpdate, ErrorMsg: constants.ErrorDatabaseUpdateMsg, Err: err}
+	}
+	return nil
+}
+
+// DeleteUserCollection is called by the server when a request to the DeleteCollection grpc service method is made
+// The favourites in the collection are kept and moved out of any collection.
+func (h *Handler) DeleteUserCollection(ctx context.Context, userID int64, collectionID int64) error {
+	_, err := h.retrieveCollectionFromDb(ctx, userID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	err = h.collections.DeleteCollection(ctx, userID, collectionID)
+	if err != nil {
+		return &customErr.Error{ErrorCode: constants.ErrorDatabaseDelete, ErrorMsg: constants.ErrorDatabaseDeleteMsg, Err: err}
+	}
+	return nil
+}
+
+// MoveUserFavourite is called by the server when a request to the MoveFavourite grpc service method is made
+// The favourite is moved out of any collection if collectionID is 0.
+func (h *Handler) MoveUserFavourite(ctx context.Context, userID int64, itemID int64, shopID int64, collectionID int64) error {
+	_, err := h.retrieveFavFromDb(ctx, userID, itemID, shopID)
+	if err == sql.ErrNoRows {
+		return &customErr.Error{ErrorCode: constants.ErrorItemNotInFavourites, ErrorMsg: constants.ErrorItemNotInFavouritesMsg}
+	}
+	if err != nil {
+		return &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg, Err: err}
+	}
+	if collectionID != 0 {
+		_, err = h.retrieveCollectionFromDb(ctx, userID, collectionID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = h.favourites.MoveFavourite(ctx, userID, itemID, shopID, collectionID)
+	if err != nil {
+		return &customErr.Error{ErrorCode: constants.ErrorDatabaseUpdate, ErrorMsg: constants.ErrorDatabaseUpdateMsg, Err: err}
+	}
+	return nil
+}
+
+// AnnotateUserFavourite is called by the server when a request to the AnnotateFav grpc service method is made
+// It replaces the favourite's note and tags. Tags are trimmed, lowercased and de-duplicated.
+func (h *Handler) AnnotateUserFavourite(ctx context.Context, userID int64, itemID int64, shopID int64, note string, tags []string) error {
+	if utf8.RuneCountInString(note) > maxNoteLength {
+		return &customErr.Error{ErrorCode: constants.ErrorInvalidNote, ErrorMsg: constants.ErrorInvalidNoteMsg}
+	}
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return err
+	}
+
+	fav, err := h.retrieveFavFromDb(ctx, userID, itemID, shopID)
+	if err == sql.ErrNoRows {
+		return &customErr.Error{ErrorCode: constants.ErrorItemNotInFavourites, ErrorMsg: constants.ErrorItemNotInFavouritesMsg}
+	}
+	if err != nil {
+		return &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg, Err: err}
+	}
+
+	err = h.favourites.SetNoteAndTags(ctx, fav.ID, userID, note, tags)
+	if err != nil {
+		return &customErr.Error{ErrorCode: constants.ErrorDatabaseUpdate, ErrorMsg: constants.ErrorDatabaseUpdateMsg, Err: err}
+	}
+	return nil
+}
+
+// GetUserTags is called by the server when a request to the ListTags grpc service method is made
+// It returns the number of the user's favourites with each tag, the most used tag first.
+func (h *Handler) GetUserTags(ctx context.Context, userID int64) ([]*pb.TagCount, error) {
+	tags, err := h.favourites.ListTags(ctx, userID)
 	if err != nil {
-		return items, 0, err
+		return nil, &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg, Err: err}
 	}
 
-	return items, totalPages, err
+	pbTags := make([]*pb.TagCount, len(tags))
+	for i, tag := range tags {
+		pbTags[i] = &pb.TagCount{
+			Tag:   tag.Tag,
+			Count: int32(tag.Count),
+		}
+	}
+	return pbTags, nil
+}
+
+// WaitForAlerts waits for the alert evaluations running in the background to finish.
+func (h *Handler) WaitForAlerts() {
+	h.evaluations.Wait()
+}
+
+// InvalidateItem removes the item's information from both tiers of the cache,
+// so that it is fetched from the external API on its next request.
+func (h *Handler) InvalidateItem(ctx context.Context, itemID int64, shopID int64) error {
+	err := h.cache.Del(ctx, util.FormatRedisKeyForItem(itemID, shopID))
+	if err != nil {
+		return &customErr.Error{ErrorCode: constants.ErrorRedisDel, ErrorMsg: constants.ErrorRedisDelMsg, Err: err}
+	}
+	return nil
 }
 
 // DeleteFavourite is called by the server when a request to the DeleteFav grpc service method is made
@@ -137,42 +826,127 @@ func (h *Handler) getItem(ctx context.Context, itemID int64, shopID int64) (*pb.
 
 	// item is not yet in cache, fetch item from external API
 	if item == nil {
+		return h.fetchItem(ctx, itemID, shopID)
+	}
+
+	if item.Unavailable {
+		// external provider reported the item as missing recently
+		return nil, &customErr.Error{ErrorCode: constants.ErrorItemUnavailable, ErrorMsg: constants.ErrorItemUnavailableMsg}
+	}
+
+	return item, err
+}
+
+// fetchItem is a helper function to fetch an item's information from the external API and save it in the cache.
+// Concurrent calls for the same item share a single fetch. The fetch is detached from the caller that started it,
+// bounded by the fetch timeout instead, so that a caller giving up does not fail the fetch for the others.
+func (h *Handler) fetchItem(ctx context.Context, itemID int64, shopID int64) (*pb.Item, error) {
+	fetched := false
+	span := ot.SpanFromContext(ctx)
+	results := h.fetches.DoChan(util.FormatRedisKeyForItem(itemID, shopID), func() (interface{}, error) {
+		fetched = true
+		timeout := time.Duration(h.config.FetchTimeout) * time.Millisecond
+		ctx, cancel := context.WithTimeout(ot.ContextWithSpan(context.Background(), span), timeout)
+		defer cancel()
+
 		// item not in cache, fetch item information from external api
 		externalRes, err := h.fetchItemInfoFromExternal(ctx, itemID, shopID)
+		if errors.Is(err, external.ErrCircuitOpen) {
+			// external provider is unavailable, serve the last known item instead
+			return h.retrieveStaleItemFromRedis(ctx, itemID, shopID, err)
+		}
+		var itemErr *external.ItemError
+		if errors.As(err, &itemErr) {
+			// external provider reported the item as missing, cache that so it is not asked again
+			h.addUnavailableItemToRedis(ctx, itemID, shopID)
+			return nil, &customErr.Error{ErrorCode: constants.ErrorItemUnavailable, ErrorMsg: constants.ErrorItemUnavailableMsg, Err: err}
+		}
 		if err != nil {
 			return nil, err
 		}
 
-		item = &pb.Item{
+		item := &pb.Item{
 			ItemID: externalRes.ItemID,
 			ShopID: externalRes.ShopID,
 			Price:  externalRes.Price,
 			Name:   externalRes.Name,
 		}
 
+		// record the price if it changed since it was last seen
+		h.recordPrice(ctx, item)
+		// keep the item's information in its favourites up to date for sorting and filtering
+		h.updateItemSnapshot(ctx, item)
+
+		// alert the users whose alert rule the fresh price meets, without delaying the response
+		h.evaluations.Add(1)
+		go func() {
+			defer h.evaluations.Done()
+			h.alerts.Evaluate(ot.ContextWithSpan(context.Background(), ot.SpanFromContext(ctx)), item)
+		}()
+
 		// save item in cache
 		err = h.addItemToRedis(ctx, itemID, shopID, item)
-		if err != nil {
-			return item, err
-		}
+		return item, err
+	})
+
+	var res singleflight.Result
+	select {
+	case res = <-results:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if !fetched {
+		// the call was collapsed into a fetch already in flight
+		metrics.ItemFetchesCollapsed.Inc()
 	}
 
-	return item, err
+	item, _ := res.Val.(*pb.Item)
+	return item, res.Err
 }
 
 // retrieveItemFromRedis is a helper function to retrieve an item's information from redis.
 // An item is identified by its itemID and shopID
 func (h *Handler) retrieveItemFromRedis(ctx context.Context, itemID int64, shopID int64) (*pb.Item, error) {
-	var item pb.Item
+	bytes, err := h.cache.Get(ctx, util.FormatRedisKeyForItem(itemID, shopID))
+	// unexpected error occured with redis op
+	if err != nil {
+		return nil, err
+	}
+
+	return h.unmarshalItem(bytes, itemID, shopID)
+}
 
-	bytes, err := h.redisManager.Get(ctx, util.FormatRedisKeyForItem(itemID, shopID))
+// retrieveItemsFromRedis is a helper function to retrieve the information of the favourites' items from redis
+// in a single round trip. Items that are not in redis are nil.
+func (h *Handler) retrieveItemsFromRedis(ctx context.Context, favourites []db.Favourite) ([]*pb.Item, error) {
+	keys := make([]string, len(favourites))
+	for i, fav := range favourites {
+		keys[i] = util.FormatRedisKeyForItem(fav.ItemID, fav.ShopID)
+	}
+
+	values, err := h.cache.MGet(ctx, keys)
 	// unexpected error occured with redis op
 	if err != nil {
 		return nil, err
 	}
 
+	items := make([]*pb.Item, len(favourites))
+	for i, fav := range favourites {
+		items[i], err = h.unmarshalItem(values[i], fav.ItemID, fav.ShopID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// unmarshalItem is a helper function to unmarshal an item's information retrieved from redis.
+// If the item is not in redis or has incomplete information, nil is returned.
+func (h *Handler) unmarshalItem(bytes []byte, itemID int64, shopID int64) (*pb.Item, error) {
+	var item pb.Item
+
 	// unmarshal bytes
-	err = util.UnmarshalProto(bytes, &item)
+	err := util.UnmarshalProto(bytes, &item)
 
 	if err != nil {
 		// error occured when unmarshalling
@@ -190,6 +964,11 @@ func (h *Handler) retrieveItemFromRedis(ctx context.Context, itemID int64, shopI
 		zap.Any(constants.Item, item),
 	)
 
+	if item.Unavailable && item.ItemID != 0 && item.ShopID != 0 {
+		// negative entry for an item the external provider reported as missing
+		return &item, err
+	}
+
 	if item.ItemID == 0 || item.ShopID == 0 || item.Price == 0 || item.Name == "" {
 		// item is not in redis or has incomplete information
 		return nil, nil
@@ -198,6 +977,40 @@ func (h *Handler) retrieveItemFromRedis(ctx context.Context, itemID int64, shopI
 	return &item, err
 }
 
+// retrieveStaleItemFromRedis is a helper function to retrieve the last known information of an item from redis,
+// for when its information cannot be fetched from the external provider.
+// The item is marked as stale. If there is no stale copy of the item, externalErr is returned.
+func (h *Handler) retrieveStaleItemFromRedis(ctx context.Context, itemID int64, shopID int64, externalErr error) (*pb.Item, error) {
+	bytes, err := h.cache.Get(ctx, util.FormatRedisKeyForStaleItem(itemID, shopID))
+	if err != nil || bytes == nil {
+		return nil, externalErr
+	}
+
+	var item pb.Item
+	err = util.UnmarshalProto(bytes, &item)
+	if err != nil {
+		// error occured when unmarshalling
+		h.logger.Error(
+			constants.ErrorUnmarshalMsg,
+			zap.Int64(constants.ItemID, itemID),
+			zap.Int64(constants.ShopID, shopID),
+			zap.Error(err),
+		)
+		return nil, externalErr
+	}
+
+	item.Stale = true
+	if span := ot.SpanFromContext(ctx); span != nil {
+		span.SetTag(tracing.ItemStale, true)
+	}
+	h.logger.Info(
+		constants.InfoItemServedStale,
+		zap.Int64(constants.ItemID, itemID),
+		zap.Int64(constants.ShopID, shopID),
+	)
+	return &item, nil
+}
+
 // addItemToRedis is a helper function to add an item to redis.
 func (h *Handler) addItemToRedis(ctx context.Context, itemID int64, shopID int64, item *pb.Item) error {
 	// marshal into bytes to store in redis
@@ -216,7 +1029,22 @@ func (h *Handler) addItemToRedis(ctx context.Context, itemID int64, shopID int64
 
 	expire := time.Duration(h.config.RedisConfig.Expire) * time.Second
 
-	err = h.redisManager.Set(ctx, util.FormatRedisKeyForItem(itemID, shopID), bytes, expire)
+	err = h.cache.Set(ctx, util.FormatRedisKeyForItem(itemID, shopID), bytes, expire)
+	if err != nil {
+		h.logger.Error(
+			constants.ErrorRedisSetMsg,
+			zap.Int64(constants.ItemID, itemID),
+			zap.Int64(constants.ShopID, shopID),
+			zap.Any(constants.Item, item),
+			zap.Error(err),
+		)
+		return &customErr.Error{constants.ErrorRedisSet, constants.ErrorRedisSetMsg, err}
+	}
+
+	// keep a longer lived copy to serve while the external provider is unavailable
+	staleExpire := time.Duration(h.config.RedisConfig.StaleExpire) * time.Second
+
+	err = h.cache.Set(ctx, util.FormatRedisKeyForStaleItem(itemID, shopID), bytes, staleExpire)
 	if err != nil {
 		h.logger.Error(
 			constants.ErrorRedisSetMsg,
@@ -231,19 +1059,126 @@ func (h *Handler) addItemToRedis(ctx context.Context, itemID int64, shopID int64
 	return err
 }
 
-// retrieveFavFromDb is a helper function called to perform a query on the database for a user's favourited item.
-// It returns the user's favourited item as well as the used query and error.
-// If the user does not have the item under their favourites, retrieveFavFromDb returns nil.
-func (h *Handler) retrieveFavFromDb(ctx context.Context, userID int64, itemID int64, shopID int64) (*db.Favourite, string, error) {
-	var fav db.Favourite
-	query := fmt.Sprintf("SELECT * FROM Favourites WHERE userID='%d' AND itemID='%d' AND shopID='%d'", userID, itemID, shopID)
-	err := h.dbManager.QueryOne(ctx, query, constants.GetFavList, &fav.ID, &fav.UserID, &fav.ItemID, &fav.ShopID, &fav.TimeAdded)
-	return &fav, query, err
+// recordPrice is a helper function to add the item's price to its price history if it differs from the last recorded price.
+// Failing to record the price is logged and not returned, since the item can still be served.
+func (h *Handler) recordPrice(ctx context.Context, item *pb.Item) {
+	latest, err := h.priceHistory.LatestPricePoint(ctx, item.ItemID, item.ShopID)
+	if err != nil && err != sql.ErrNoRows {
+		h.logger.Error(
+			constants.ErrorDatabaseQueryMsg,
+			zap.Int64(constants.ItemID, item.ItemID),
+			zap.Int64(constants.ShopID, item.ShopID),
+			zap.Error(err),
+		)
+		return
+	}
+	if latest != nil && latest.Price == item.Price {
+		// price has not changed
+		return
+	}
+
+	err = h.priceHistory.AddPricePoint(ctx, item.ItemID, item.ShopID, item.Price)
+	if err != nil {
+		h.logger.Error(
+			constants.ErrorDatabaseInsertMsg,
+			zap.Int64(constants.ItemID, item.ItemID),
+			zap.Int64(constants.ShopID, item.ShopID),
+			zap.Int64(constants.Price, item.Price),
+			zap.Error(err),
+		)
+		return
+	}
+	h.logger.Info(
+		constants.InfoPriceRecorded,
+		zap.Int64(constants.ItemID, item.ItemID),
+		zap.Int64(constants.ShopID, item.ShopID),
+		zap.Int64(constants.Price, item.Price),
+	)
+}
+
+// updateItemSnapshot is a helper function to update the item's information in its favourites.
+// Failing to update it is logged and not returned, since the item can still be served and is sorted by its previous information.
+func (h *Handler) updateItemSnapshot(ctx context.Context, item *pb.Item) {
+	err := h.favourites.UpdateItemSnapshot(ctx, item.ItemID, item.ShopID, db.ItemSnapshot{Name: item.Name, Price: item.Price})
+	if err != nil {
+		h.logger.Error(
+			constants.ErrorDatabaseUpdateMsg,
+			zap.String(constants.OpName, constants.UpdateItemSnapshot),
+			zap.Int64(constants.ItemID, item.ItemID),
+			zap.Int64(constants.ShopID, item.ShopID),
+			zap.Error(err),
+		)
+	}
+}
+
+// addUnavailableItemToRedis is a helper function to add a negative entry to redis for an item
+// the external provider reported as missing. The entry expires after the negative expiry.
+// Failing to add the entry is logged and not returned, since it only means the external provider is asked again.
+func (h *Handler) addUnavailableItemToRedis(ctx context.Context, itemID int64, shopID int64) {
+	h.logger.Info(
+		constants.InfoItemUnavailable,
+		zap.Int64(constants.ItemID, itemID),
+		zap.Int64(constants.ShopID, shopID),
+	)
+
+	bytes, err := util.MarshalProto(unavailableItem(itemID, shopID))
+	if err != nil {
+		// error occured when marshalling
+		h.logger.Error(
+			constants.ErrorMarshalMsg,
+			zap.Int64(constants.ItemID, itemID),
+			zap.Int64(constants.ShopID, shopID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	expire := time.Duration(h.config.RedisConfig.NegativeExpire) * time.Second
+
+	err = h.cache.Set(ctx, util.FormatRedisKeyForItem(itemID, shopID), bytes, expire)
+	if err != nil {
+		h.logger.Error(
+			constants.ErrorRedisSetMsg,
+			zap.Int64(constants.ItemID, itemID),
+			zap.Int64(constants.ShopID, shopID),
+			zap.Error(err),
+		)
+	}
 }
 
-func (h *Handler) addFavIntoDb(ctx context.Context, userID int64, itemID int64, shopID int64) error {
-	query := fmt.Sprintf("INSERT INTO Favourites(userID, itemID, shopID) VALUES('%d','%d','%d')", userID, itemID, shopID)
-	id, err := h.dbManager.InsertRow(ctx, query, constants.AddFav)
+// unavailableItem returns the placeholder for an item the external provider reported as missing.
+func unavailableItem(itemID int64, shopID int64) *pb.Item {
+	return &pb.Item{ItemID: itemID, ShopID: shopID, Unavailable: true, ErrorCode: constants.ErrorItemUnavailable}
+}
+
+// errorCode returns the service error code of the error.
+func errorCode(err error) int32 {
+	v, ok := err.(*customErr.Error)
+	if !ok {
+		return constants.ErrorTypecast
+	}
+	return v.ErrorCode
+}
+
+// isItemUnavailable reports whether the error is for an item the external provider reported as missing.
+func isItemUnavailable(err error) bool {
+	v, ok := err.(*customErr.Error)
+	return ok && v.ErrorCode == constants.ErrorItemUnavailable
+}
+
+// retrieveFavFromDb is a helper function called to retrieve a user's favourited item from the favourites repository.
+// If the user does not have the item under their favourites, retrieveFavFromDb returns sql.ErrNoRows.
+func (h *Handler) retrieveFavFromDb(ctx context.Context, userID int64, itemID int64, shopID int64) (*db.Favourite, error) {
+	return h.favourites.Get(ctx, userID, itemID, shopID)
+}
+
+// addFavIntoDb is a helper function to add the item to the user's favourites in the favourites repository.
+func (h *Handler) addFavIntoDb(ctx context.Context, userID int64, itemID int64, shopID int64, item db.ItemSnapshot, rule db.AlertRule) error {
+	id, err := h.favourites.Add(ctx, userID, itemID, shopID, item, rule)
+	if err == db.ErrDuplicateFavourite {
+		// item was added to the user's favourites by a concurrent request
+		return &customErr.Error{ErrorCode: constants.ErrorItemInFavourites, ErrorMsg: constants.InfoItemInFavourites}
+	}
 	if err != nil {
 		// error occured when inserting user into database
 		return &customErr.Error{constants.ErrorDatabaseInsert, constants.ErrorDatabaseInsertMsg, err}
@@ -259,11 +1194,145 @@ func (h *Handler) addFavIntoDb(ctx context.Context, userID int64, itemID int64,
 	return err
 }
 
-// removeFavFromDb is a helper function to delete a user's favourite from the database.
-func (h *Handler) removeFavFromDb(ctx context.Context, userID int64, itemID int64, shopID int64) error {
-	query := fmt.Sprintf("DELETE FROM Favourites WHERE userID='%d' and itemid='%d' and shopID='%d'", userID, itemID, shopID)
+// retrieveCollectionFromDb is a helper function to retrieve the user's collection from the collections repository.
+// It returns an error with ErrorCollectionNotFound if the user has no collection with the ID.
+func (h *Handler) retrieveCollectionFromDb(ctx context.Context, userID int64, collectionID int64) (*db.Collection, error) {
+	collection, err := h.collections.GetCollection(ctx, userID, collectionID)
+	if err == sql.ErrNoRows {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorCollectionNotFound, ErrorMsg: constants.ErrorCollectionNotFoundMsg}
+	}
+	if err != nil {
+		h.logger.Error(
+			constants.ErrorDatabaseQueryMsg,
+			zap.Int64(constants.UserID, userID),
+			zap.Int64(constants.CollectionID, collectionID),
+			zap.Error(err),
+		)
+		return nil, &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg, Err: err}
+	}
+	return collection, nil
+}
+
+// collectionToProto is a helper function to convert a collection to its proto message.
+func collectionToProto(collection db.Collection) *pb.Collection {
+	return &pb.Collection{
+		Id:          collection.ID,
+		Name:        collection.Name,
+		Count:       int32(collection.Count),
+		TimeCreated: collection.TimeCreated.Unix(),
+	}
+}
 
-	rowsDeleted, err := h.dbManager.DeleteOne(ctx, query, constants.DeleteFav)
+// validateCollectionName is a helper function to trim the collection name and check that it is not empty,
+// and fits the name column.
+func validateCollectionName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxCollectionNameLength {
+		return "", &customErr.Error{ErrorCode: constants.ErrorInvalidCollectionName, ErrorMsg: constants.ErrorInvalidCollectionNameMsg}
+	}
+	return name, nil
+}
+
+// normalizeTags is a helper function to normalize the tags, and drop duplicates.
+// It returns an error if there are too many tags, or a tag is empty or too long.
+func normalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if tag == "" || utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, &customErr.Error{ErrorCode: constants.ErrorInvalidTags, ErrorMsg: constants.ErrorInvalidTagsMsg}
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+	if len(normalized) > maxTagsPerFavourite {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorInvalidTags, ErrorMsg: constants.ErrorInvalidTagsMsg}
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// favouriteToRecord is a helper function to convert a favourite to its export record.
+func favouriteToRecord(fav db.Favourite) export.Record {
+	return export.Record{
+		ItemID:      fav.ItemID,
+		ShopID:      fav.ShopID,
+		Name:        fav.Item.Name,
+		Price:       fav.Item.Price,
+		TimeAdded:   fav.TimeAdded,
+		Note:        fav.Note,
+		Tags:        fav.Tags,
+		TargetPrice: fav.TargetPrice,
+		DropPercent: fav.DropPercent,
+	}
+}
+
+// validateRecord is a helper function to validate an imported record.
+// It returns the record with its tags normalized, or an error if any of its fields are invalid.
+func validateRecord(record export.Record) (export.Record, error) {
+	if record.ItemID <= 0 || record.ShopID <= 0 || record.Price < 0 || utf8.RuneCountInString(record.Name) > maxItemNameLength {
+		return record, &customErr.Error{ErrorCode: constants.ErrorInvalidImportFile, ErrorMsg: constants.ErrorInvalidImportFileMsg}
+	}
+	if utf8.RuneCountInString(record.Note) > maxNoteLength {
+		return record, &customErr.Error{ErrorCode: constants.ErrorInvalidNote, ErrorMsg: constants.ErrorInvalidNoteMsg}
+	}
+	err := validateAlertRule(record.TargetPrice, record.DropPercent)
+	if err != nil {
+		return record, err
+	}
+	if record.TimeAdded.Before(time.Unix(1, 0)) || record.TimeAdded.After(time.Now()) {
+		// the favourite is added now if its time added is unknown or out of range
+		record.TimeAdded = time.Time{}
+	}
+	record.Tags, err = normalizeTags(record.Tags)
+	return record, err
+}
+
+// encodeCursor is a helper function to encode the cursor into an opaque string, along with the sort order it was taken in.
+func encodeCursor(cursor db.FavouriteCursor, sort db.FavouriteSort) string {
+	raw := fmt.Sprintf("%d:%d:%d", sort, cursor.TimeAdded.UnixNano(), cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor is a helper function to decode a cursor encoded by encodeCursor.
+// It returns an error if the cursor is malformed or was taken in another sort order.
+func decodeCursor(cursor string, sort db.FavouriteSort) (*db.FavouriteCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	var cursorSort db.FavouriteSort
+	var timeAdded, id int64
+	_, err = fmt.Sscanf(string(raw), "%d:%d:%d", &cursorSort, &timeAdded, &id)
+	if err != nil {
+		return nil, err
+	}
+	if cursorSort != sort {
+		return nil, fmt.Errorf("cursor taken in sort order %d, not %d", cursorSort, sort)
+	}
+	return &db.FavouriteCursor{TimeAdded: time.Unix(0, timeAdded), ID: id}, nil
+}
+
+// normalizeTag is a helper function to trim and lowercase the tag, so that tags differing in case are the same tag.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// validateAlertRule is a helper function to check that the alert's target price is not negative,
+// and its percentage drop is less than 100.
+func validateAlertRule(targetPrice int64, dropPercent int32) error {
+	if targetPrice < 0 || dropPercent < 0 || dropPercent >= 100 {
+		return &customErr.Error{ErrorCode: constants.ErrorInvalidAlertRule, ErrorMsg: constants.ErrorInvalidAlertRuleMsg}
+	}
+	return nil
+}
+
+// removeFavFromDb is a helper function to delete a user's favourite from the favourites repository.
+func (h *Handler) removeFavFromDb(ctx context.Context, userID int64, itemID int64, shopID int64) error {
+	rowsDeleted, err := h.favourites.Delete(ctx, userID, itemID, shopID)
 
 	// unexpected error occured or no rows deleted
 	if err != nil || rowsDeleted != 1 {
@@ -277,102 +1346,47 @@ func (h *Handler) removeFavFromDb(ctx context.Context, userID int64, itemID int6
 	return err
 }
 
-// fetchItemInfoFromExternal is a helper function for making external HTTP calls to fetch the item's information.
+// fetchItemInfoFromExternal is a helper function for fetching the item's information from the external item catalog.
 // If the call is successful, the item is returned.
 // Else, nil is returned alongside an error.
 func (h *Handler) fetchItemInfoFromExternal(ctx context.Context, itemID int64, shopID int64) (*pb.Item, error) {
-	res, err := shopee.FetchItemPrice(ctx, &h.config.ExternalConfig.Shopee, h.logger, itemID, shopID)
-
-	if err != nil {
-		// external api call error
-		return nil, err
-	}
-
-	if res.Error != 0 {
-		// shopee api returned error
-		h.logger.Error(
-			constants.ErrorExternalShopeeAPICallMsg,
-			zap.Int64(constants.ItemID, itemID),
-			zap.Int64(constants.ShopID, shopID),
-			zap.Any(constants.Res, res),
-		)
-		return nil, &customErr.Error{constants.ErrorExternalShopeeAPICall, constants.ErrorExternalShopeeAPICallMsg, err}
-	}
-
-	return &pb.Item{
-		ItemID: res.ItemData.ItemID,
-		ShopID: res.ItemData.ShopID,
-		Name:   res.ItemData.Name,
-		Price:  res.ItemData.Price,
-	}, err
+	return h.catalog.FetchItem(ctx, itemID, shopID)
 }
 
 // retrieveFavListFromDb is a helper function to retrieve all of a user's, identified by their userID, favourites.
 // It returns a list of db.Favourite
-func (h *Handler) retrieveFavListFromDb(ctx context.Context, userID int64, page int) ([]db.Favourite, error) {
-	query := fmt.Sprintf("SELECT * FROM Favourites WHERE userID='%d' ORDER BY timeAdded desc LIMIT %d OFFSET %d", userID, h.config.MaxPerPage, h.config.MaxPerPage*page)
-
-	// query rows
-	rows, err := h.dbManager.QueryRows(ctx, query, constants.GetFavList)
+func (h *Handler) retrieveFavListFromDb(ctx context.Context, userID int64, filter db.FavouriteFilter, sort db.FavouriteSort, page int) ([]db.Favourite, error) {
+	favourites, err := h.favourites.List(ctx, userID, filter, sort, h.config.MaxPerPage, h.config.MaxPerPage*page)
 	if err != nil {
 		// error occured when querying
 		h.logger.Error(
 			constants.ErrorDatabaseQueryMsg,
 			zap.Int64(constants.UserID, userID),
 			zap.Int(constants.Page, page),
-			zap.String(constants.Query, query),
-		)
-		return nil, &customErr.Error{
-			constants.ErrorDatabaseQuery, constants.ErrorDatabaseQueryMsg,
-			err,
-		}
-	}
-
-	var favourites []db.Favourite
-	// Loop through rows, using Scan to assign column data to struct fields.
-	for rows.Next() {
-		var fav db.Favourite
-		err := rows.Scan(&fav.ID, &fav.UserID, &fav.ItemID, &fav.ShopID, &fav.TimeAdded)
-		if err != nil {
-			// error occured when scanning
-			h.logger.Error(
-				constants.ErrorDatabaseQueryMsg,
-				zap.Error(err),
-			)
-			return favourites, &customErr.Error{constants.ErrorDatabaseQuery, constants.ErrorDatabaseQueryMsg, err}
-		}
-		favourites = append(favourites, fav)
-	}
-	err = rows.Err()
-	if err != nil {
-		h.logger.Error(
-			constants.ErrorDatabaseQueryMsg,
 			zap.Error(err),
 		)
-		return favourites, &customErr.Error{constants.ErrorDatabaseQuery, constants.ErrorDatabaseQueryMsg, err}
+		return favourites, &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg, Err: err}
 	}
 	h.logger.Info(
 		constants.InfoDatabaseQueryRows,
 		zap.Int64(constants.UserID, userID),
-		zap.String(constants.Query, query),
+		zap.Int(constants.Page, page),
 		zap.Any(constants.Res, favourites),
 	)
 	return favourites, err
 }
 
-// getFavouritesCount is a helper function used to count the total number of favourited items a user has.
-func (h *Handler) getFavouritesCount(ctx context.Context, userID int64) (int32, error) {
-	query := fmt.Sprintf("SELECT count(*) FROM Favourites WHERE userID='%d'", userID)
-	var count int
-	err := h.dbManager.QueryOne(ctx, query, constants.GetFavCount, &count)
+// getFavouritesCount is a helper function used to count the total number of favourited items a user has selected by the filter.
+func (h *Handler) getFavouritesCount(ctx context.Context, userID int64, filter db.FavouriteFilter) (int32, error) {
+	count, err := h.favourites.Count(ctx, userID, filter)
 	if err != nil {
 		return 0, &customErr.Error{constants.ErrorDatabaseQuery, constants.ErrorDatabaseQueryMsg, err}
 	}
 
 	h.logger.Info(
 		constants.InfoDatabaseQuery,
+		zap.Int64(constants.UserID, userID),
 		zap.Int(constants.Count, count),
-		zap.String(constants.Query, query),
 	)
 
 	numPages := util.CalculateNumberOfPages(count, h.config.MaxPerPage)
@@ -0,0 +1,418 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	alerts "itemService/alerts"
+	config "itemService/config"
+	constants "itemService/constants"
+	db "itemService/db"
+	"itemService/export"
+	"itemService/external/shopee"
+	"itemService/external/shopee/shopeetest"
+	pb "itemService/proto"
+	"itemService/util"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// testHandler returns a handler backed by the in-memory repository and cache, fetching items from a fake Shopee server
+// with the default fixtures. The server is closed when the test finishes.
+func testHandler(t *testing.T) (*Handler, *db.MemoryRepository, *shopeetest.Server) {
+	t.Helper()
+	server := shopeetest.NewServer(shopeetest.DefaultFixtures()...)
+	t.Cleanup(server.Close)
+
+	cfg := &config.Config{
+		MaxPerPage:     2,
+		MaxPageSize:    10,
+		MaxBatchSize:   5,
+		MaxImportSize:  10,
+		MaxCollections: 5,
+		FetchTimeout:   2000,
+		RedisConfig:    config.RedisConfig{Expire: 600, StaleExpire: 3600, NegativeExpire: 60},
+	}
+	shopeeConfig := &config.Shopee{
+		GetItem: config.API{Endpoint: server.GetItemEndpoint(), Method: "get"},
+		HTTP:    config.HTTPClientConfig{Timeout: 2000},
+	}
+	repo := db.NewMemoryRepository()
+	logger := zap.NewNop()
+	handler := &Handler{
+		config:       cfg,
+		favourites:   repo,
+		collections:  repo,
+		priceHistory: repo,
+		cache:        db.NewMemoryCache(),
+		catalog:      shopee.NewClient(shopeeConfig, logger),
+		alerts:       alerts.NewEvaluator(repo, alerts.NewLogNotifier(logger), &config.AlertsConfig{}, logger),
+		logger:       logger,
+	}
+	t.Cleanup(handler.WaitForAlerts)
+	return handler, repo, server
+}
+
+// itemIDs returns the itemIDs of the items, in order.
+func itemIDs(items []*pb.Item) []int64 {
+	ids := make([]int64, len(items))
+	for i, item := range items {
+		ids[i] = item.ItemID
+	}
+	return ids
+}
+
+func sameIDs(a []int64, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAddItemToUserFavList(t *testing.T) {
+	ctx := context.Background()
+	h, repo, _ := testHandler(t)
+
+	item, err := h.AddItemToUserFavList(ctx, 1001, 2001, 1, 0, 10)
+	if err != nil {
+		t.Fatalf("AddItemToUserFavList: %v", err)
+	}
+	if item.Name != "Wireless Mouse" || item.Price != 1590000 {
+		t.Errorf("AddItemToUserFavList: got item %v", item)
+	}
+	fav, err := repo.Get(ctx, 1, 1001, 2001)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if fav.Item.Price != 1590000 || fav.DropPercent != 10 || fav.BasePrice != 1590000 {
+		t.Errorf("favourite: got %+v", fav)
+	}
+
+	_, err = h.AddItemToUserFavList(ctx, 1001, 2001, 1, 0, 0)
+	if errorCode(err) != constants.ErrorItemInFavourites {
+		t.Errorf("adding again: got error %v, want ErrorItemInFavourites", err)
+	}
+	_, err = h.AddItemToUserFavList(ctx, 1004, 2002, 1, 0, 0)
+	if errorCode(err) != constants.ErrorItemUnavailable {
+		t.Errorf("adding a deleted item: got error %v, want ErrorItemUnavailable", err)
+	}
+}
+
+func TestGetUserFavouritesServesCachedItems(t *testing.T) {
+	ctx := context.Background()
+	h, repo, server := testHandler(t)
+	repo.Add(ctx, 1, 1001, 2001, db.ItemSnapshot{}, db.AlertRule{})
+	repo.Add(ctx, 1, 1002, 2001, db.ItemSnapshot{}, db.AlertRule{})
+	repo.Add(ctx, 1, 1003, 2002, db.ItemSnapshot{}, db.AlertRule{})
+
+	items, totalPages, partial, err := h.GetUserFavourites(ctx, 1, db.FavouriteFilter{}, db.SortAddedDesc, 0)
+	if err != nil {
+		t.Fatalf("GetUserFavourites: %v", err)
+	}
+	if !sameIDs(itemIDs(items), []int64{1003, 1002}) || totalPages != 2 || partial {
+		t.Errorf("GetUserFavourites: got items %v, %d pages, partial %v", itemIDs(items), totalPages, partial)
+	}
+	if items[0].Name != "USB-C Hub" || items[0].Price != 3250000 {
+		t.Errorf("GetUserFavourites: got item %v", items[0])
+	}
+
+	// the items are cached, so the page is served without calling the catalog again
+	requests := server.Requests()
+	if _, _, _, err = h.GetUserFavourites(ctx, 1, db.FavouriteFilter{}, db.SortAddedDesc, 0); err != nil {
+		t.Fatalf("GetUserFavourites: %v", err)
+	}
+	if got := server.Requests(); got != requests {
+		t.Errorf("cached page: got %d catalog requests, want %d", got-requests, 0)
+	}
+
+	if _, _, _, err = h.GetUserFavourites(ctx, 1, db.FavouriteFilter{}, db.SortAddedDesc, -1); errorCode(err) != constants.ErrorInvalidPage {
+		t.Errorf("negative page: got error %v, want ErrorInvalidPage", err)
+	}
+}
+
+func TestGetUserFavouritesNegativeCachingAndPartialFailures(t *testing.T) {
+	ctx := context.Background()
+	h, repo, server := testHandler(t)
+	h.config.MaxPerPage = 10
+	repo.Add(ctx, 1, 1001, 2001, db.ItemSnapshot{}, db.AlertRule{})
+	repo.Add(ctx, 1, 1004, 2002, db.ItemSnapshot{}, db.AlertRule{})
+	repo.Add(ctx, 1, 1006, 2003, db.ItemSnapshot{}, db.AlertRule{})
+
+	items, _, partial, err := h.GetUserFavourites(ctx, 1, db.FavouriteFilter{}, db.SortAddedAsc, 0)
+	if err != nil {
+		t.Fatalf("GetUserFavourites: %v", err)
+	}
+	if !partial {
+		t.Error("GetUserFavourites: got partial false, want true for the item the catalog failed on")
+	}
+	if items[0].Name != "Wireless Mouse" || items[0].ErrorCode != 0 {
+		t.Errorf("available item: got %v", items[0])
+	}
+	if !items[1].Unavailable || items[1].ErrorCode != constants.ErrorItemUnavailable {
+		t.Errorf("deleted item: got %v, want an unavailable placeholder", items[1])
+	}
+	if items[2].ErrorCode == 0 || items[2].Unavailable {
+		t.Errorf("failed item: got %v, want a stub with an error code", items[2])
+	}
+
+	// the deleted item is cached as unavailable, and only the failed item is fetched again
+	requests := server.Requests()
+	items, _, _, err = h.GetUserFavourites(ctx, 1, db.FavouriteFilter{}, db.SortAddedAsc, 0)
+	if err != nil {
+		t.Fatalf("GetUserFavourites: %v", err)
+	}
+	if !items[1].Unavailable {
+		t.Errorf("deleted item from the cache: got %v, want an unavailable placeholder", items[1])
+	}
+	if got := server.Requests() - requests; got != 1 {
+		t.Errorf("second page: got %d catalog requests, want 1", got)
+	}
+}
+
+func TestConcurrentMissesShareOneFetch(t *testing.T) {
+	h, _, server := testHandler(t)
+
+	// the first caller gives up before the slow item is fetched, which does not fail the fetch for the others
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	go h.getItem(ctx, 1005, 2003)
+	time.Sleep(10 * time.Millisecond)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			item, err := h.getItem(context.Background(), 1005, 2003)
+			if err == nil && item.Price != 990000 {
+				t.Errorf("getItem: got item %v", item)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("getItem: unexpected error %v", err)
+		}
+	}
+	if got := server.Requests(); got != 1 {
+		t.Errorf("concurrent misses: got %d catalog requests, want 1", got)
+	}
+}
+
+// memoryRedis is a db.RedisTier backed by a MemoryCache, standing in for redis behind a TieredCache.
+type memoryRedis struct {
+	*db.MemoryCache
+}
+
+func (r memoryRedis) Get(ctx context.Context, key string) ([]byte, time.Duration, error) {
+	bytes, _ := r.MemoryCache.Get(ctx, key)
+	ttl, _ := r.MemoryCache.TTL(ctx, key)
+	return bytes, ttl, nil
+}
+
+func (r memoryRedis) MGet(ctx context.Context, keys []string) ([][]byte, []time.Duration, error) {
+	values := make([][]byte, len(keys))
+	ttls := make([]time.Duration, len(keys))
+	for i, key := range keys {
+		values[i], ttls[i], _ = r.Get(ctx, key)
+	}
+	return values, ttls, nil
+}
+
+func TestInvalidateItemClearsBothCacheTiers(t *testing.T) {
+	ctx := context.Background()
+	h, repo, server := testHandler(t)
+	redis := memoryRedis{MemoryCache: db.NewMemoryCache()}
+	h.cache = db.NewTieredCache(&config.RedisConfig{LocalSize: 10, LocalExpire: 600}, redis)
+	repo.Add(ctx, 1, 1001, 2001, db.ItemSnapshot{}, db.AlertRule{})
+
+	// the item is fetched and cached in both tiers
+	if _, _, _, err := h.GetUserFavourites(ctx, 1, db.FavouriteFilter{}, db.SortAddedDesc, 0); err != nil {
+		t.Fatalf("GetUserFavourites: %v", err)
+	}
+	key := util.FormatRedisKeyForItem(1001, 2001)
+	if bytes, _ := redis.MemoryCache.Get(ctx, key); bytes == nil {
+		t.Fatalf("item not cached in redis")
+	}
+
+	if err := h.InvalidateItem(ctx, 1001, 2001); err != nil {
+		t.Fatalf("InvalidateItem: %v", err)
+	}
+	if bytes, _ := redis.MemoryCache.Get(ctx, key); bytes != nil {
+		t.Errorf("InvalidateItem: item still in redis")
+	}
+
+	// the local tier is cleared too, so the item is fetched from the catalog again
+	requests := server.Requests()
+	if _, _, _, err := h.GetUserFavourites(ctx, 1, db.FavouriteFilter{}, db.SortAddedDesc, 0); err != nil {
+		t.Fatalf("GetUserFavourites: %v", err)
+	}
+	if got := server.Requests(); got != requests+1 {
+		t.Errorf("after InvalidateItem: got %d catalog requests, want 1", got-requests)
+	}
+}
+
+func TestGetUserFavouritesSortFilterAndCursor(t *testing.T) {
+	ctx := context.Background()
+	h, _, _ := testHandler(t)
+	for _, item := range []struct{ itemID, shopID int64 }{{1001, 2001}, {1002, 2001}, {1003, 2002}} {
+		if _, err := h.AddItemToUserFavList(ctx, item.itemID, item.shopID, 1, 0, 0); err != nil {
+			t.Fatalf("AddItemToUserFavList: %v", err)
+		}
+	}
+	fav, _ := h.favourites.Get(ctx, 1, 1003, 2002)
+	h.favourites.SetNoteAndTags(ctx, fav.ID, 1, "", []string{"desk"})
+
+	items, _, _, err := h.GetUserFavourites(ctx, 1, db.FavouriteFilter{}, db.SortPriceAsc, 0)
+	if err != nil {
+		t.Fatalf("GetUserFavourites: %v", err)
+	}
+	if !sameIDs(itemIDs(items), []int64{1001, 1003}) {
+		t.Errorf("sorted by price: got %v, want [1001 1003]", itemIDs(items))
+	}
+
+	items, _, _, err = h.GetUserFavourites(ctx, 1, db.FavouriteFilter{MinPrice: 2000000, ShopID: 2001}, db.SortAddedDesc, 0)
+	if err != nil {
+		t.Fatalf("GetUserFavourites: %v", err)
+	}
+	if !sameIDs(itemIDs(items), []int64{1002}) {
+		t.Errorf("filtered by shop and price: got %v, want [1002]", itemIDs(items))
+	}
+
+	items, _, _, err = h.GetUserFavourites(ctx, 1, db.FavouriteFilter{Tag: " Desk "}, db.SortAddedDesc, 0)
+	if err != nil {
+		t.Fatalf("GetUserFavourites: %v", err)
+	}
+	if !sameIDs(itemIDs(items), []int64{1003}) || len(items[0].Tags) != 1 {
+		t.Errorf("filtered by tag: got %v", items)
+	}
+
+	// the cursor continues the listing after the last favourite of the page
+	var listed []int64
+	cursor := ""
+	for page := 0; page < 3; page++ {
+		items, next, _, err := h.GetUserFavouritesAfter(ctx, 1, db.FavouriteFilter{}, db.SortAddedAsc, cursor, 2)
+		if err != nil {
+			t.Fatalf("GetUserFavouritesAfter: %v", err)
+		}
+		listed = append(listed, itemIDs(items)...)
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	if !sameIDs(listed, []int64{1001, 1002, 1003}) {
+		t.Errorf("listed with cursors: got %v, want [1001 1002 1003]", listed)
+	}
+	if _, _, _, err = h.GetUserFavouritesAfter(ctx, 1, db.FavouriteFilter{}, db.SortAddedDesc, cursor, 2); errorCode(err) != constants.ErrorInvalidCursor {
+		t.Errorf("cursor in another sort order: got error %v, want ErrorInvalidCursor", err)
+	}
+}
+
+func TestBatchAddAndDeleteUserFavourites(t *testing.T) {
+	ctx := context.Background()
+	h, repo, _ := testHandler(t)
+	repo.Add(ctx, 1, 1002, 2001, db.ItemSnapshot{}, db.AlertRule{})
+
+	results, err := h.BatchAddItemsToUserFavList(ctx, 1, []*pb.BatchFavItem{
+		{ItemID: 1001, ShopID: 2001},
+		{ItemID: 1002, ShopID: 2001},
+		{ItemID: 1004, ShopID: 2002},
+		{ItemID: 1003, ShopID: 2002, DropPercent: 100},
+	})
+	if err != nil {
+		t.Fatalf("BatchAddItemsToUserFavList: %v", err)
+	}
+	want := []pb.BatchFavStatus{pb.BatchFavStatus_ADDED, pb.BatchFavStatus_ALREADY_EXISTS, pb.BatchFavStatus_UNAVAILABLE}
+	for i, status := range want {
+		if results[i].Status != status {
+			t.Errorf("result %d: got status %v, want %v", i, results[i].Status, status)
+		}
+	}
+	if results[3].ErrorCode != constants.ErrorInvalidAlertRule {
+		t.Errorf("result 3: got error code %d, want ErrorInvalidAlertRule", results[3].ErrorCode)
+	}
+
+	results, err = h.BatchDeleteUserFavourites(ctx, 1, []*pb.BatchFavItem{{ItemID: 1001, ShopID: 2001}, {ItemID: 1003, ShopID: 2002}})
+	if err != nil {
+		t.Fatalf("BatchDeleteUserFavourites: %v", err)
+	}
+	if results[0].Status != pb.BatchFavStatus_DELETED || results[1].Status != pb.BatchFavStatus_NOT_FOUND {
+		t.Errorf("BatchDeleteUserFavourites: got %v", results)
+	}
+	if count, _ := repo.Count(ctx, 1, db.FavouriteFilter{}); count != 1 {
+		t.Errorf("favourites left: got %d, want 1", count)
+	}
+
+	if _, err = h.BatchAddItemsToUserFavList(ctx, 1, make([]*pb.BatchFavItem, 6)); errorCode(err) != constants.ErrorInvalidBatchSize {
+		t.Errorf("batch too large: got error %v, want ErrorInvalidBatchSize", err)
+	}
+}
+
+func TestImportUserFavouritesKeepsTimeAddedAndMeasuresDropsFromCurrentPrice(t *testing.T) {
+	ctx := context.Background()
+	h, repo, _ := testHandler(t)
+	added := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	data := []byte(`[
+		{"itemID": 1001, "shopID": 2001, "name": "Wireless Mouse", "price": 9990000, "timeAdded": "2021-03-04T05:06:07Z", "dropPercent": 10},
+		{"itemID": 1004, "shopID": 2002, "name": "Old Item", "price": 100, "timeAdded": "2099-01-01T00:00:00Z", "targetPrice": 50, "dropPercent": 10}
+	]`)
+
+	imported, _, invalid, err := h.ImportUserFavourites(ctx, 1, export.JSON, data)
+	if err != nil || imported != 2 || len(invalid) != 0 {
+		t.Fatalf("ImportUserFavourites: got %d imported, invalid %v, error %v", imported, invalid, err)
+	}
+
+	fav, _ := repo.Get(ctx, 1, 1001, 2001)
+	if !fav.TimeAdded.Equal(added) {
+		t.Errorf("imported favourite: got time added %v, want %v", fav.TimeAdded, added)
+	}
+	if fav.DropPercent != 10 || fav.BasePrice != 1590000 {
+		t.Errorf("imported favourite: got drop %d%% from %d, want 10%% from the current price 1590000", fav.DropPercent, fav.BasePrice)
+	}
+
+	// the drop of an item that cannot be fetched is not imported, and a time added in the future is replaced
+	fav, _ = repo.Get(ctx, 1, 1004, 2002)
+	if fav.DropPercent != 0 || fav.TargetPrice != 50 {
+		t.Errorf("imported unavailable favourite: got rule %+v, want only the target price", fav.AlertRule)
+	}
+	if fav.TimeAdded.After(time.Now()) {
+		t.Errorf("imported unavailable favourite: got time added %v in the future", fav.TimeAdded)
+	}
+}
+
+func TestImportUserFavouritesFetchesPricesAPageAtATime(t *testing.T) {
+	ctx := context.Background()
+	h, repo, server := testHandler(t)
+	h.config.MaxPageSize = 3
+
+	var records []string
+	for itemID := int64(5001); itemID <= 5008; itemID++ {
+		server.SetFixture(shopeetest.Fixture{ItemID: itemID, ShopID: 6001, Name: "Item", Price: 1000, LatencyMs: 20})
+		records = append(records, fmt.Sprintf(`{"itemID": %d, "shopID": 6001, "name": "Item", "price": 2000, "dropPercent": 10}`, itemID))
+	}
+	data := []byte("[" + strings.Join(records, ",") + "]")
+
+	imported, _, _, err := h.ImportUserFavourites(ctx, 1, export.JSON, data)
+	if err != nil || imported != 8 {
+		t.Fatalf("ImportUserFavourites: got %d imported, error %v", imported, err)
+	}
+	if got := server.MaxInFlight(); got > 3 {
+		t.Errorf("ImportUserFavourites: got %d concurrent catalog requests, want at most 3", got)
+	}
+	if fav, _ := repo.Get(ctx, 1, 5008, 6001); fav.BasePrice != 1000 {
+		t.Errorf("imported favourite: got base price %d, want the current price 1000", fav.BasePrice)
+	}
+}
@@ -0,0 +1,215 @@
+package server
+
+import (
+	"context"
+	config "itemService/config"
+	constants "itemService/constants"
+	db "itemService/db"
+	metrics "itemService/metrics"
+	pb "itemService/proto"
+	util "itemService/util"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const (
+	refreshItems = "refresher.RefreshItems"
+)
+
+// Refresher is a background worker that refreshes the most favourited items in the cache before they expire,
+// so that readers do not pay the latency of the external API when the items expire.
+type Refresher struct {
+	handler *Handler
+	config  *config.RefresherConfig
+	logger  *zap.Logger
+	// cancel stops the worker and the refreshes in flight
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewRefresher returns a Refresher that refreshes items through the handler.
+func NewRefresher(handler *Handler, config *config.RefresherConfig, logger *zap.Logger) *Refresher {
+	return &Refresher{
+		handler: handler,
+		config:  config,
+		logger:  logger,
+		done:    make(chan struct{}),
+	}
+}
+
+// Start starts the worker, which refreshes items once every interval until Stop is called.
+// It does nothing if the refresher is disabled in the config.
+func (r *Refresher) Start() {
+	if !r.config.Enabled {
+		close(r.done)
+		return
+	}
+
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.logger.Info(constants.InfoRefresherStart)
+
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(time.Duration(r.config.Interval) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.refreshItems(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the worker and waits for the refreshes in flight to finish.
+func (r *Refresher) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	<-r.done
+	r.logger.Info(constants.InfoRefresherStop)
+}
+
+// refreshItems checks the most favourited items and refreshes those close to expiry,
+// up to the budget and rate limit in the config.
+func (r *Refresher) refreshItems(ctx context.Context) {
+	// start tracing span for the run
+	span, ctx := ot.StartSpanFromContext(ctx, refreshItems)
+	defer span.Finish()
+
+	items, err := r.handler.favourites.TopFavourited(ctx, r.config.Candidates)
+	if err != nil {
+		r.logger.Error(
+			constants.ErrorDatabaseQueryMsg,
+			zap.String(constants.OpName, constants.TopFavourited),
+			zap.Error(err),
+		)
+		return
+	}
+
+	refreshBefore := time.Duration(r.config.RefreshBefore) * time.Second
+	// limit is nil, and never waited on, without a rate limit
+	var limit <-chan time.Time
+	if r.config.RateLimit > 0 {
+		limiter := time.NewTicker(time.Second / time.Duration(r.config.RateLimit))
+		defer limiter.Stop()
+		limit = limiter.C
+	}
+	// sem bounds the number of refreshes in flight
+	concurrency := r.config.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+
+	budget := r.config.Budget
+	var refreshed, failed, skipped int64
+	defer func() {
+		r.logger.Info(
+			constants.InfoRefresherRun,
+			zap.Int64(constants.Refreshed, refreshed),
+			zap.Int64(constants.Failed, failed),
+			zap.Int64(constants.Skipped, skipped),
+		)
+	}()
+	// wait for the refreshes in flight before logging the run
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	// read the cached items in a single round trip, to tell the unavailable ones apart
+	favourites := make([]db.Favourite, len(items))
+	for i, item := range items {
+		favourites[i] = db.Favourite{ItemID: item.ItemID, ShopID: item.ShopID}
+	}
+	cached, err := r.handler.retrieveItemsFromRedis(ctx, favourites)
+	if err != nil {
+		return
+	}
+
+	for i, item := range items {
+		refresh, err := r.needsRefresh(ctx, item, cached[i], refreshBefore)
+		if err != nil {
+			atomic.AddInt64(&failed, 1)
+			metrics.ItemRefreshes.WithLabelValues(constants.Failed).Inc()
+			continue
+		}
+		if !refresh || budget == 0 {
+			skipped++
+			metrics.ItemRefreshes.WithLabelValues(constants.Skipped).Inc()
+			continue
+		}
+		budget--
+
+		// wait for the rate limiter and a free slot
+		if limit != nil {
+			select {
+			case <-limit:
+			case <-ctx.Done():
+				return
+			}
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+
+		wg.Add(1)
+		go func(item db.FavouritedItem) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if r.refreshItem(ctx, item.ItemID, item.ShopID) {
+				atomic.AddInt64(&refreshed, 1)
+				metrics.ItemRefreshes.WithLabelValues(constants.Refreshed).Inc()
+			} else {
+				atomic.AddInt64(&failed, 1)
+				metrics.ItemRefreshes.WithLabelValues(constants.Failed).Inc()
+			}
+		}(item)
+	}
+}
+
+// needsRefresh reports whether the item, cached as the given item, should be refreshed.
+// Items close to expiry are refreshed. Items missing from the cache are refreshed too, since they are among the most
+// favourited and their next reader would wait on the external API. Items the external API reported as missing are not,
+// so that the negative entry expires and the item is only asked for again when a reader needs it.
+func (r *Refresher) needsRefresh(ctx context.Context, item db.FavouritedItem, cached *pb.Item, refreshBefore time.Duration) (bool, error) {
+	if cached == nil {
+		return true, nil
+	}
+	if cached.Unavailable {
+		return false, nil
+	}
+	ttl, err := r.handler.cache.TTL(ctx, util.FormatRedisKeyForItem(item.ItemID, item.ShopID))
+	if err != nil {
+		return false, err
+	}
+	// -1 means the item does not expire, -2 that it expired since it was read
+	return ttl != -1 && ttl <= refreshBefore, nil
+}
+
+// refreshItem fetches the item from the external API and saves it in the cache.
+// It reports whether the item was refreshed. Items the external API reports as missing count as refreshed.
+func (r *Refresher) refreshItem(ctx context.Context, itemID int64, shopID int64) bool {
+	item, err := r.handler.fetchItem(ctx, itemID, shopID)
+	if isItemUnavailable(err) {
+		return true
+	}
+	if err != nil || item.Stale {
+		r.logger.Error(
+			constants.ErrorRefreshItemMsg,
+			zap.Int64(constants.ItemID, itemID),
+			zap.Int64(constants.ShopID, shopID),
+			zap.Error(err),
+		)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	config "itemService/config"
+	db "itemService/db"
+	pb "itemService/proto"
+	util "itemService/util"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestRefresherRefreshesExpiringAndMissingItems(t *testing.T) {
+	ctx := context.Background()
+	h, repo, server := testHandler(t)
+	for _, item := range []struct{ itemID, shopID int64 }{{1001, 2001}, {1002, 2001}, {1003, 2002}, {1004, 2002}} {
+		repo.Add(ctx, 1, item.itemID, item.shopID, db.ItemSnapshot{}, db.AlertRule{})
+	}
+
+	// 1001 is about to expire, 1002 is fresh, 1003 is not cached and 1004 was reported as missing
+	bytes, _ := util.MarshalProto(&pb.Item{ItemID: 1001, ShopID: 2001, Name: "Wireless Mouse", Price: 1590000})
+	h.cache.Set(ctx, util.FormatRedisKeyForItem(1001, 2001), bytes, 10*time.Second)
+	h.addItemToRedis(ctx, 1002, 2001, &pb.Item{ItemID: 1002, ShopID: 2001, Name: "Mechanical Keyboard", Price: 8900000})
+	h.addUnavailableItemToRedis(ctx, 1004, 2002)
+
+	r := NewRefresher(h, &config.RefresherConfig{Candidates: 10, RefreshBefore: 60, Concurrency: 2, Budget: 10}, zap.NewNop())
+	r.refreshItems(ctx)
+
+	if got := server.Requests(); got != 2 {
+		t.Errorf("refreshItems: got %d catalog requests, want 2", got)
+	}
+	for _, item := range []struct{ itemID, shopID int64 }{{1001, 2001}, {1003, 2002}} {
+		ttl, _ := h.cache.TTL(ctx, util.FormatRedisKeyForItem(item.itemID, item.shopID))
+		if ttl <= time.Minute {
+			t.Errorf("item %d: got TTL %v after refresh, want the full expiry", item.itemID, ttl)
+		}
+	}
+	cached, _ := h.retrieveItemFromRedis(ctx, 1004, 2002)
+	if cached == nil || !cached.Unavailable {
+		t.Errorf("item 1004: got %v, want the negative entry kept", cached)
+	}
+}
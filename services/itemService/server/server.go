@@ -5,12 +5,19 @@ import (
 	"fmt"
 	"itemService/tracing"
 	"net/http"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
+	"time"
 
+	alerts "itemService/alerts"
 	config "itemService/config"
 	constants "itemService/constants"
 	db "itemService/db"
 	customErr "itemService/errors"
+	export "itemService/export"
+	external "itemService/external"
 	metrics "itemService/metrics"
 	pb "itemService/proto"
 	"net"
@@ -30,8 +37,23 @@ import (
 
 const (
 	addFav     = "server.AddFav"
+	updateFav  = "server.UpdateFav"
 	deleteFav  = "server.DeleteFav"
 	getFavList = "server.GetFavList"
+
+	getItemPriceHistory = "server.GetItemPriceHistory"
+	createCollection    = "server.CreateCollection"
+	listCollections     = "server.ListCollections"
+	renameCollection    = "server.RenameCollection"
+	deleteCollection    = "server.DeleteCollection"
+	moveFavourite       = "server.MoveFavourite"
+	annotateFav         = "server.AnnotateFav"
+	listTags            = "server.ListTags"
+	batchAddFav         = "server.BatchAddFav"
+	batchDeleteFav      = "server.BatchDeleteFav"
+	deleteAllFavourites = "server.DeleteAllFavourites"
+	exportFavourites    = "server.ExportFavourites"
+	importFavourites    = "server.ImportFavourites"
 )
 
 // Server struct contains a reference to the handler. Used to start the grpc server.
@@ -43,11 +65,15 @@ type Server struct {
 }
 
 // StartServer initialises the prometheus metrics, starts the HTTP server for the prometheus endpoint and starts the GRPC server.
-func (s *Server) StartServer(config *config.Config, logger *zap.Logger, dbManager *db.DatabaseManager, redisManager *db.RedisManager, tracer ot.Tracer) {
+func (s *Server) StartServer(config *config.Config, logger *zap.Logger, repository db.Repository, cache db.Cache, catalog external.ItemCatalog, tracer ot.Tracer) {
 	s.handler = Handler{
 		config:       config,
-		dbManager:    dbManager,
-		redisManager: redisManager,
+		favourites:   repository,
+		collections:  repository,
+		priceHistory: repository,
+		cache:        cache,
+		catalog:      catalog,
+		alerts:       alerts.NewEvaluator(repository, alerts.NewNotifier(&config.AlertsConfig, logger), &config.AlertsConfig, logger),
 		logger:       logger,
 	}
 	s.logger = logger
@@ -67,7 +93,10 @@ func (s *Server) StartServer(config *config.Config, logger *zap.Logger, dbManage
 
 	grpcServer := grpc.NewServer(
 		grpc.StreamInterceptor(
-			metrics.GrpcMetrics.StreamServerInterceptor(),
+			grpc_middleware.ChainStreamServer(
+				metrics.GrpcMetrics.StreamServerInterceptor(),
+				otgrpc.OpenTracingStreamServerInterceptor(tracer),
+			),
 		),
 		grpc.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
@@ -101,6 +130,24 @@ func (s *Server) StartServer(config *config.Config, logger *zap.Logger, dbManage
 		}
 	}()
 
+	// start the background refresher, stopped along with the grpc server
+	refresher := NewRefresher(&s.handler, &config.RefresherConfig, logger)
+	refresher.Start()
+
+	// stop gracefully on SIGINT and SIGTERM
+	// stopped is closed once the requests and the alerts evaluated for them are done
+	stopped := make(chan struct{})
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+		<-sig
+		logger.Info(constants.InfoServerShutdown)
+		refresher.Stop()
+		grpcServer.GracefulStop()
+		s.handler.WaitForAlerts()
+		close(stopped)
+	}()
+
 	reflection.Register(grpcServer)
 	err = grpcServer.Serve(listener)
 	if err != nil {
@@ -109,6 +156,8 @@ func (s *Server) StartServer(config *config.Config, logger *zap.Logger, dbManage
 			zap.Error(err),
 		)
 	}
+	// Serve returns as soon as the graceful stop begins
+	<-stopped
 	if err != nil {
 		logger.Fatal(
 			constants.ErrorServerStartFailMsg,
@@ -175,7 +224,7 @@ func (s *Server) AddFav(ctx context.Context, req *pb.AddFavReq) (*pb.AddFavRes,
 		timer.ObserveDuration()
 	}()
 
-	item, err := s.handler.AddItemToUserFavList(ctx, req.ItemID, req.ShopID, req.UserID)
+	item, err := s.handler.AddItemToUserFavList(ctx, req.ItemID, req.ShopID, req.UserID, req.TargetPrice, req.DropPercent)
 
 	if err != nil {
 		v, ok := err.(*customErr.Error)
@@ -200,6 +249,235 @@ func (s *Server) AddFav(ctx context.Context, req *pb.AddFavReq) (*pb.AddFavRes,
 	}, nil
 }
 
+// BatchAddFav implements the grpc service method, as defined in service.proto
+func (s *Server) BatchAddFav(ctx context.Context, req *pb.BatchAddFavReq) (*pb.BatchAddFavRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, batchAddFav)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.BatchAddFav, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	results, err := s.handler.BatchAddItemsToUserFavList(ctx, req.UserID, req.Items)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.BatchAddFavRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.BatchAddFavRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+	return &pb.BatchAddFavRes{
+		ErrorCode: -1,
+		Results:   results,
+	}, nil
+}
+
+// BatchDeleteFav implements the grpc service method, as defined in service.proto
+func (s *Server) BatchDeleteFav(ctx context.Context, req *pb.BatchDeleteFavReq) (*pb.BatchDeleteFavRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, batchDeleteFav)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.BatchDeleteFav, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	results, err := s.handler.BatchDeleteUserFavourites(ctx, req.UserID, req.Items)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.BatchDeleteFavRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.BatchDeleteFavRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+	return &pb.BatchDeleteFavRes{
+		ErrorCode: -1,
+		Results:   results,
+	}, nil
+}
+
+// DeleteAllFavourites implements the grpc service method, as defined in service.proto
+func (s *Server) DeleteAllFavourites(ctx context.Context, req *pb.DeleteAllFavouritesReq) (*pb.DeleteAllFavouritesRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, deleteAllFavourites)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.DeleteAllFav, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	deleted, err := s.handler.DeleteAllUserFavourites(ctx, req.UserID)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.DeleteAllFavouritesRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.DeleteAllFavouritesRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+	return &pb.DeleteAllFavouritesRes{
+		ErrorCode: -1,
+		Deleted:   deleted,
+	}, nil
+}
+
+// ExportFavourites implements the grpc service method, as defined in service.proto
+// An error that occurs after the stream has started is sent as the last message of the stream.
+func (s *Server) ExportFavourites(req *pb.ExportFavouritesReq, stream pb.ItemService_ExportFavouritesServer) error {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(stream.Context(), exportFavourites)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.ExportFavourites, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	err := s.handler.ExportUserFavourites(ctx, req.UserID, export.Format(req.Format), func(data []byte) error {
+		return stream.Send(&pb.ExportFavouritesRes{
+			ErrorCode: -1,
+			Data:      data,
+		})
+	})
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			// the stream failed, so the error cannot be sent on it
+			s.logger.Error(constants.ErrorExportMsg, zap.Int64(constants.UserID, req.UserID), zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return err
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return stream.Send(&pb.ExportFavouritesRes{
+			ErrorCode: v.ErrorCode,
+		})
+	}
+	return nil
+}
+
+// ImportFavourites implements the grpc service method, as defined in service.proto
+func (s *Server) ImportFavourites(ctx context.Context, req *pb.ImportFavouritesReq) (*pb.ImportFavouritesRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, importFavourites)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.ImportFavourites, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	imported, duplicates, invalidRecords, err := s.handler.ImportUserFavourites(ctx, req.UserID, export.Format(req.Format), req.Data)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.ImportFavouritesRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.ImportFavouritesRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+	return &pb.ImportFavouritesRes{
+		ErrorCode:      -1,
+		Imported:       imported,
+		Duplicates:     duplicates,
+		InvalidRecords: invalidRecords,
+	}, nil
+}
+
+// UpdateFav implements the grpc service method, as defined in service.proto
+func (s *Server) UpdateFav(ctx context.Context, req *pb.UpdateFavReq) (*pb.UpdateFavRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, updateFav)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.UpdateFav, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	err := s.handler.UpdateUserFavAlert(ctx, req.ItemID, req.ShopID, req.UserID, req.TargetPrice, req.DropPercent)
+
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(
+				constants.ErrorTypecastMsg,
+				zap.Error(err),
+			)
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.UpdateFavRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.UpdateFavRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+	return &pb.UpdateFavRes{
+		ErrorCode: -1,
+	}, nil
+}
+
 // GetFavList implements the grpc service method, as defined in service.proto
 func (s *Server) GetFavList(ctx context.Context, req *pb.GetFavListReq) (*pb.GetFavListRes, error) {
 	// start tracing span from context
@@ -216,7 +494,26 @@ func (s *Server) GetFavList(ctx context.Context, req *pb.GetFavListReq) (*pb.Get
 		timer.ObserveDuration()
 	}()
 
-	items, totalPages, err := s.handler.GetUserFavourites(ctx, req.UserID, req.Page)
+	filter := db.FavouriteFilter{
+		CollectionID: req.CollectionID,
+		Tag:          req.Tag,
+		ShopID:       req.ShopID,
+		MinPrice:     req.MinPrice,
+		MaxPrice:     req.MaxPrice,
+	}
+	// the values of FavSort match the db sort orders
+	sort := db.FavouriteSort(req.Sort)
+	var items []*pb.Item
+	var totalPages int32
+	var nextCursor string
+	var partial bool
+	var err error
+	if req.Cursor != "" || req.PageSize != 0 {
+		// list with a cursor
+		items, nextCursor, partial, err = s.handler.GetUserFavouritesAfter(ctx, req.UserID, filter, sort, req.Cursor, req.PageSize)
+	} else {
+		items, totalPages, partial, err = s.handler.GetUserFavourites(ctx, req.UserID, filter, sort, req.Page)
+	}
 	if err != nil {
 		v, ok := err.(*customErr.Error)
 		if !ok {
@@ -232,10 +529,324 @@ func (s *Server) GetFavList(ctx context.Context, req *pb.GetFavListReq) (*pb.Get
 		}, nil
 	}
 
+	if partial {
+		// some items could not be retrieved, the rest of the page is still returned
+		metrics.PartialResponses.WithLabelValues(s.config.ServiceLabel, constants.GetFavList).Inc()
+		span.SetTag(tracing.Partial, true)
+	}
+
 	return &pb.GetFavListRes{
 		ErrorCode:  -1,
 		Items:      items,
 		TotalPages: totalPages,
+		Partial:    partial,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetItemPriceHistory implements the grpc service method, as defined in service.proto
+func (s *Server) GetItemPriceHistory(ctx context.Context, req *pb.GetItemPriceHistoryReq) (*pb.GetItemPriceHistoryRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, getItemPriceHistory)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.GetItemPriceHistory, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	// to defaults to the current time
+	to := time.Now()
+	if req.To != 0 {
+		to = time.Unix(req.To, 0)
+	}
+
+	points, err := s.handler.GetItemPriceHistory(ctx, req.ItemID, req.ShopID, time.Unix(req.From, 0), to)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.GetItemPriceHistoryRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.GetItemPriceHistoryRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+
+	return &pb.GetItemPriceHistoryRes{
+		ErrorCode: -1,
+		Points:    points,
+	}, nil
+}
+
+// CreateCollection implements the grpc service method, as defined in service.proto
+func (s *Server) CreateCollection(ctx context.Context, req *pb.CreateCollectionReq) (*pb.CreateCollectionRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, createCollection)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.CreateCollection, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	collection, err := s.handler.CreateUserCollection(ctx, req.UserID, req.Name)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.CreateCollectionRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.CreateCollectionRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+
+	return &pb.CreateCollectionRes{
+		ErrorCode:  -1,
+		Collection: collection,
+	}, nil
+}
+
+// ListCollections implements the grpc service method, as defined in service.proto
+func (s *Server) ListCollections(ctx context.Context, req *pb.ListCollectionsReq) (*pb.ListCollectionsRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, listCollections)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.ListCollections, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	collections, err := s.handler.GetUserCollections(ctx, req.UserID)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.ListCollectionsRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.ListCollectionsRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+
+	return &pb.ListCollectionsRes{
+		ErrorCode:   -1,
+		Collections: collections,
+	}, nil
+}
+
+// RenameCollection implements the grpc service method, as defined in service.proto
+func (s *Server) RenameCollection(ctx context.Context, req *pb.RenameCollectionReq) (*pb.RenameCollectionRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, renameCollection)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.RenameCollection, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	err := s.handler.RenameUserCollection(ctx, req.UserID, req.CollectionID, req.Name)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.RenameCollectionRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.RenameCollectionRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+
+	return &pb.RenameCollectionRes{
+		ErrorCode: -1,
+	}, nil
+}
+
+// DeleteCollection implements the grpc service method, as defined in service.proto
+func (s *Server) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionReq) (*pb.DeleteCollectionRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, deleteCollection)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.DeleteCollection, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	err := s.handler.DeleteUserCollection(ctx, req.UserID, req.CollectionID)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.DeleteCollectionRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.DeleteCollectionRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+
+	return &pb.DeleteCollectionRes{
+		ErrorCode: -1,
+	}, nil
+}
+
+// MoveFavourite implements the grpc service method, as defined in service.proto
+func (s *Server) MoveFavourite(ctx context.Context, req *pb.MoveFavouriteReq) (*pb.MoveFavouriteRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, moveFavourite)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.MoveFav, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	err := s.handler.MoveUserFavourite(ctx, req.UserID, req.ItemID, req.ShopID, req.CollectionID)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.MoveFavouriteRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.MoveFavouriteRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+
+	return &pb.MoveFavouriteRes{
+		ErrorCode: -1,
+	}, nil
+}
+
+// AnnotateFav implements the grpc service method, as defined in service.proto
+func (s *Server) AnnotateFav(ctx context.Context, req *pb.AnnotateFavReq) (*pb.AnnotateFavRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, annotateFav)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.AnnotateFav, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	err := s.handler.AnnotateUserFavourite(ctx, req.UserID, req.ItemID, req.ShopID, req.Note, req.Tags)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.AnnotateFavRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.AnnotateFavRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+
+	return &pb.AnnotateFavRes{
+		ErrorCode: -1,
+	}, nil
+}
+
+// ListTags implements the grpc service method, as defined in service.proto
+func (s *Server) ListTags(ctx context.Context, req *pb.ListTagsReq) (*pb.ListTagsRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, listTags)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := constants.NilErrorCode
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.ListTags, errorCodeStr).Observe(v)
+	}))
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	tags, err := s.handler.GetUserTags(ctx, req.UserID)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.ListTagsRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.ListTagsRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+
+	return &pb.ListTagsRes{
+		ErrorCode: -1,
+		Tags:      tags,
 	}, nil
 }
 
@@ -25,6 +25,12 @@ const (
 	PeerPort = "peer.port"
 	// PeerAddress tag
 	PeerAddress = "peer.address"
+	// HTTPMethod tag
+	HTTPMethod = "http.method"
+	// HTTPURL tag
+	HTTPURL = "http.url"
+	// HTTPStatusCode tag
+	HTTPStatusCode = "http.status_code"
 
 	// custom tag keys
 
@@ -32,6 +38,18 @@ const (
 	ServiceErrorCode = "service.errorCode"
 	// ServiceErrorMsg custom tag key
 	ServiceErrorMsg = "service.errorMsg"
+	// RetryAttempt custom tag key
+	RetryAttempt = "retry.attempt"
+	// RetryReason custom tag key
+	RetryReason = "retry.reason"
+	// BreakerName custom tag key
+	BreakerName = "breaker.name"
+	// BreakerState custom tag key
+	BreakerState = "breaker.state"
+	// ItemStale custom tag key
+	ItemStale = "item.stale"
+	// Partial custom tag key
+	Partial = "response.partial"
 
 	// log fields
 
@@ -33,5 +33,11 @@ const (
 	// DatabaseStatementRedisSet for <db.statement> with format specifiers for key and value
 	DatabaseStatementRedisSet = "SET %s %s"
 	// DatabaseStatementRedisGet for <db.statement> with format specifiers for key and value
-	DatabaseStatementRedisGet = "GET %s"
+	DatabaseStatementRedisGet = "GET %[1]s; PTTL %[1]s"
+	// DatabaseStatementRedisMGet for <db.statement> with format specifier for the keys
+	DatabaseStatementRedisMGet = "MGET %s; PTTL"
+	// DatabaseStatementRedisDel for <db.statement> with format specifier for the keys
+	DatabaseStatementRedisDel = "DEL %s"
+	// DatabaseStatementRedisTTL for <db.statement> with format specifier for the key
+	DatabaseStatementRedisTTL = "TTL %s"
 )
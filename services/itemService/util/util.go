@@ -12,6 +12,11 @@ func FormatRedisKeyForItem(itemID int64, shopID int64) string {
 	return fmt.Sprintf("%d:%d", itemID, shopID)
 }
 
+// FormatRedisKeyForStaleItem returns a string in the form stale:itemid:shopId
+func FormatRedisKeyForStaleItem(itemID int64, shopID int64) string {
+	return fmt.Sprintf("stale:%d:%d", itemID, shopID)
+}
+
 // MarshalProto marshals a protobuf message into bytes
 func MarshalProto(message proto.Message) ([]byte, error) {
 	return proto.Marshal(message)
@@ -13,6 +13,12 @@ type Config struct {
 	DbConfig         DbConfig         `mapstructure:db`
 	PrometheusConfig PrometheusConfig `mapstructure:prometheus`
 	JaegerConfig     JaegerConfig     `mapstructure:"jaeger"`
+	KeyRingConfig    KeyRingConfig    `mapstructure:"keyRing"`
+	LoginGuardConfig LoginGuardConfig `mapstructure:"loginGuard"`
+	// TokenExpiry is the time in minutes until a token expires
+	TokenExpiry int `mapstructure:"tokenExpiry"`
+	// RefreshExpiry is the time in minutes until a session expires, extended each time the session is refreshed
+	RefreshExpiry int `mapstructure:"refreshExpiry"`
 }
 
 // DbConfig holds configurations for the database.
@@ -0,0 +1,17 @@
+package config
+
+// KeyRingConfig holds config for the keys that sign and verify JWT tokens
+type KeyRingConfig struct {
+	// SigningKey is the ID of the key that signs new tokens
+	SigningKey string      `mapstructure:"signingKey"`
+	Keys       []KeyConfig `mapstructure:"keys"`
+}
+
+// KeyConfig holds config for a key in the key ring.
+// A key that only verifies tokens, such as a key being rotated out, needs only its public key file.
+type KeyConfig struct {
+	ID             string `mapstructure:"id"`
+	Algorithm      string `mapstructure:"algorithm"`
+	PrivateKeyFile string `mapstructure:"privateKeyFile"`
+	PublicKeyFile  string `mapstructure:"publicKeyFile"`
+}
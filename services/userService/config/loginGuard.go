@@ -0,0 +1,30 @@
+package config
+
+// LoginGuardConfig holds configurations for limiting failed login attempts per username and per client IP.
+// Failed attempts are counted in a sliding window, stored in memory or in redis.
+type LoginGuardConfig struct {
+	// Backend is memory to count attempts in this process, or redis to share the counts between instances
+	Backend string `mapstructure:"backend"`
+	// Window is the time in seconds that a failed attempt is counted
+	Window int `mapstructure:"window"`
+	// MaxFailures is the number of failed attempts for a username in the window that locks the account
+	MaxFailures int `mapstructure:"maxFailures"`
+	// MaxIPFailures is the number of failed attempts from a client IP in the window that locks the client IP
+	MaxIPFailures int `mapstructure:"maxIPFailures"`
+	// Lockout is the time in seconds that an account or client IP is locked
+	Lockout int `mapstructure:"lockout"`
+	// BaseDelay is the time in milliseconds before another attempt for a username after its first failed attempt,
+	// doubled with each further failed attempt up to MaxDelay
+	BaseDelay int `mapstructure:"baseDelay"`
+	// MaxDelay is the maximum time in seconds before another attempt for a username
+	MaxDelay    int         `mapstructure:"maxDelay"`
+	RedisConfig RedisConfig `mapstructure:"redis"`
+}
+
+// RedisConfig holds configurations for redis
+type RedisConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     string `mapstructure:"port"`
+	Password string `mapstructure:"password"`
+	Db       int    `mapstructure:"db"`
+}
@@ -21,6 +21,22 @@ const (
 	AddUser = "addUser"
 	// GetUserByUsername string
 	GetUserByUsername = "getUserByUsername"
+	// GetUserByID string
+	GetUserByID = "getUserByID"
+	// DeleteUser string
+	DeleteUser = "deleteUser"
+	// AddSession string
+	AddSession = "addSession"
+	// GetSession string
+	GetSession = "getSession"
+	// RotateSession string
+	RotateSession = "rotateSession"
+	// RevokeSession string
+	RevokeSession = "revokeSession"
+	// RevokeAllSessions string
+	RevokeAllSessions = "revokeAllSessions"
+	// SessionID string
+	SessionID = "sessionID"
 	// NilErrorCode string
 	NilErrorCode = "-1"
 	// ErrorCode string
@@ -37,10 +53,22 @@ const (
 	Select = "SELECT"
 	// Delete string
 	Delete = "DELETE"
+	// Update string
+	Update = "UPDATE"
 	// OpName string
 	OpName = "opName"
 	// MySQL string
 	MySQL = "mysql"
+	// Redis string
+	Redis = "redis"
+	// Key string
+	Key = "key"
+	// Account string
+	Account = "account"
+	// ClientIP string
+	ClientIP = "clientIP"
+	// RetryAfter string
+	RetryAfter = "retryAfter"
 	// True string
 	True = "true"
 	// False string
@@ -51,4 +79,14 @@ const (
 	Login = "login"
 	// Signup string
 	Signup = "signup"
+	// DeleteAccount string
+	DeleteAccount = "deleteAccount"
+	// RefreshSession string
+	RefreshSession = "refreshSession"
+	// CheckSession string
+	CheckSession = "checkSession"
+	// ValidateToken string
+	ValidateToken = "validateToken"
+	// GetJWKS string
+	GetJWKS = "getJWKS"
 )
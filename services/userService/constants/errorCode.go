@@ -7,6 +7,14 @@ const (
 	ErrorUserAlreadyExists = 240011
 	ErrorUserDoesNotExist  = 240012
 	ErrorUserPassword      = 240013
+	// sessions
+	ErrorRefreshTokenInvalid = 240014
+	ErrorRefreshTokenExpired = 240015
+	ErrorRefreshTokenReused  = 240016
+	ErrorSessionRevoked      = 240017
+	ErrorTokenInvalid        = 240018
+	// login attempts
+	ErrorTooManyLoginAttempts = 240019
 
 	// 500 errors
 	// server errors
@@ -16,9 +24,12 @@ const (
 	ErrorDatabaseInsert     = 250012
 	ErrorDatabaseQuery      = 250013
 	ErrorDatabaseConnection = 250014
+	ErrorDatabaseDelete     = 250015
+	ErrorDatabaseUpdate     = 250016
 
 	// encryption errors
 	ErrorPasswordEncryption = 250021
+	ErrorGenerateToken      = 250022
 
 	// typecasting
 	ErrorTypecast = 250031
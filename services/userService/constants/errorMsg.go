@@ -7,8 +7,14 @@ const (
 	ErrorDatabaseInsertMsg = "error_database_insert_failure"
 	// ErrorDatabaseQueryMsg for database query failures
 	ErrorDatabaseQueryMsg = "error_database_query_failure"
+	// ErrorDatabaseDeleteMsg for database delete failures
+	ErrorDatabaseDeleteMsg = "error_database_delete_failure"
+	// ErrorDatabaseUpdateMsg for database update failures
+	ErrorDatabaseUpdateMsg = "error_database_update_failure"
 	// ErrorDatabaseConnectionMsg for database connection errors
 	ErrorDatabaseConnectionMsg = "error_database_connection_failure"
+	// ErrorDatabasePrepareMsg for database statement preparation failures
+	ErrorDatabasePrepareMsg = "error_database_prepare_failure"
 	// ErrorPasswordEncryptionMsg for bcrypt encryption errors
 	ErrorPasswordEncryptionMsg = "error_password_encryption"
 	// ErrorServerStartFailMsg for when the grpc server fails to start
@@ -19,6 +25,26 @@ const (
 	ErrorPromHTTPServerMsg = "error_prom_http_server"
 	// ErrorUserPasswordMsg for errors with the user password
 	ErrorUserPasswordMsg = "error_user_password"
+	// ErrorGenerateTokenMsg for errors generating a session ID or refresh token
+	ErrorGenerateTokenMsg = "error_generate_token"
+	// ErrorRefreshTokenInvalidMsg for a refresh token that is malformed or belongs to no session
+	ErrorRefreshTokenInvalidMsg = "error_refresh_token_invalid"
+	// ErrorRefreshTokenExpiredMsg for a refresh token of an expired session
+	ErrorRefreshTokenExpiredMsg = "error_refresh_token_expired"
+	// ErrorRefreshTokenReusedMsg for a refresh token that was already used, which revokes its session
+	ErrorRefreshTokenReusedMsg = "error_refresh_token_reused"
+	// ErrorSessionRevokedMsg for a refresh token of a revoked session
+	ErrorSessionRevokedMsg = "error_session_revoked"
+	// ErrorTokenInvalidMsg for a token that is malformed, expired, or not signed by a key in the key ring
+	ErrorTokenInvalidMsg = "error_token_invalid"
+	// ErrorTooManyLoginAttemptsMsg for a login attempt while the account or client IP is delayed or locked
+	ErrorTooManyLoginAttemptsMsg = "error_too_many_login_attempts"
+	// ErrorLoginGuardMsg for errors recording or checking failed login attempts, which allows the attempt
+	ErrorLoginGuardMsg = "error_login_guard"
+	// ErrorRedisConnectionMsg for when user service fails to connect to redis
+	ErrorRedisConnectionMsg = "error_redis_connection"
+	// ErrorLoadKeyRingMsg for when the keys that sign tokens fail to load
+	ErrorLoadKeyRingMsg = "error_load_key_ring"
 	// ErrorTypecastMsg for errors typecasting error to customErr
 	ErrorTypecastMsg = "error_typecast"
 	// ErrorJaegerInitMsg service error message
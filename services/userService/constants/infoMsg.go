@@ -22,6 +22,19 @@ const (
 	InfoUserExists = "info_user_exists"
 	// InfoUserDoesNotExist message for logging
 	InfoUserDoesNotExist = "info_user_does_not_exist"
+	// InfoUserDeleted message for logging
+	InfoUserDeleted = "info_user_deleted"
+
+	// session
+
+	// InfoSessionCreated message for logging
+	InfoSessionCreated = "info_session_created"
+	// InfoSessionRefreshed message for logging
+	InfoSessionRefreshed = "info_session_refreshed"
+	// InfoSessionRevoked message for logging
+	InfoSessionRevoked = "info_session_revoked"
+	// InfoAllSessionsRevoked message for logging
+	InfoAllSessionsRevoked = "info_all_sessions_revoked"
 
 	// database
 
@@ -29,6 +42,18 @@ const (
 	InfoDatabaseQuery = "info_db_query"
 	// InfoDatabaseInsert message for logging
 	InfoDatabaseInsert = "info_db_insert"
+	// InfoDatabaseDelete message for logging
+	InfoDatabaseDelete = "info_db_delete"
+	// InfoDatabaseUpdate message for logging
+	InfoDatabaseUpdate = "info_db_update"
 	// InfoDatabaseConnectSuccess message for logging
 	InfoDatabaseConnectSuccess = "info_db_connect_success"
+	// InfoRedisConnectSuccess message for logging
+	InfoRedisConnectSuccess = "info_redis_connect_success"
+	// InfoLoginGuardMemoryBackend message for logging
+	InfoLoginGuardMemoryBackend = "info_login_guard_memory_backend"
+	// InfoAccountLocked message for logging
+	InfoAccountLocked = "info_account_locked"
+	// InfoClientIPLocked message for logging
+	InfoClientIPLocked = "info_client_ip_locked"
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sync"
 	config "userService/config"
 	constants "userService/constants"
 	metrics "userService/metrics"
@@ -18,13 +19,24 @@ import (
 const (
 	mysqlInsertRow = "db.InsertRow"
 	mysqlQueryOne  = "db.QueryOne"
+	mysqlDeleteOne = "db.DeleteOne"
+	mysqlUpdateRow = "db.UpdateRows"
 )
 
-// DatabaseManager is a struct containing a reference to the database connection, logger, and the database config
+// DatabaseManager is a struct containing a reference to the database connection, logger, and the database config.
+// Statements are prepared on first use and cached, keyed by their opName and query.
 type DatabaseManager struct {
-	db     *sql.DB
-	config *config.DbConfig
-	logger *zap.Logger
+	db      *sql.DB
+	config  *config.DbConfig
+	logger  *zap.Logger
+	stmtsMu sync.RWMutex
+	stmts   map[stmtKey]*sql.Stmt
+}
+
+// stmtKey identifies a cached prepared statement.
+type stmtKey struct {
+	opName string
+	query  string
 }
 
 // InitDatabase opens the database connection. It returns an error if the database fails to respond when pinged.
@@ -64,13 +76,14 @@ func InitDatabase(dbConfig *config.DbConfig, logger *zap.Logger) (*DatabaseManag
 		db:     db,
 		config: dbConfig,
 		logger: logger,
+		stmts:  make(map[stmtKey]*sql.Stmt),
 	}
 
 	return &dbManager, nil
 }
 
-// QueryOne will query for a single *sql.Row, and write its contents into destination.
-func (dm *DatabaseManager) QueryOne(ctx context.Context, query string, opName string, destination ...any) error {
+// QueryOne will query for a single *sql.Row using the given placeholder arguments, and write its contents into destination.
+func (dm *DatabaseManager) QueryOne(ctx context.Context, query string, opName string, args []any, destination ...any) error {
 	// start tracing span from context
 	span, ctx := ot.StartSpanFromContext(ctx, mysqlQueryOne)
 	dm.addSpanTags(span, query)
@@ -85,8 +98,14 @@ func (dm *DatabaseManager) QueryOne(ctx context.Context, query string, opName st
 		timer.ObserveDuration()
 	}()
 
-	res := dm.db.QueryRowContext(ctx, query)
-	err := res.Scan(destination...)
+	stmt, err := dm.prepare(ctx, query, opName)
+	if err != nil {
+		successStr = constants.False
+		return err
+	}
+
+	res := stmt.QueryRowContext(ctx, args...)
+	err = res.Scan(destination...)
 	if err != nil {
 		dm.logger.Error(
 			constants.ErrorDatabaseQueryMsg,
@@ -107,8 +126,8 @@ func (dm *DatabaseManager) QueryOne(ctx context.Context, query string, opName st
 	return err
 }
 
-// InsertRow will insert a single row and return its ID.
-func (dm *DatabaseManager) InsertRow(ctx context.Context, query string, opName string) (int64, error) {
+// InsertRow will insert a single row using the given placeholder arguments and return its ID.
+func (dm *DatabaseManager) InsertRow(ctx context.Context, query string, opName string, args ...any) (int64, error) {
 	// start tracing span from context
 	span, ctx := ot.StartSpanFromContext(ctx, mysqlInsertRow)
 	dm.addSpanTags(span, query)
@@ -123,7 +142,13 @@ func (dm *DatabaseManager) InsertRow(ctx context.Context, query string, opName s
 		timer.ObserveDuration()
 	}()
 
-	res, err := dm.db.ExecContext(ctx, query)
+	stmt, err := dm.prepare(ctx, query, opName)
+	if err != nil {
+		successStr = constants.False
+		return 0, err
+	}
+
+	res, err := stmt.ExecContext(ctx, args...)
 
 	if err != nil {
 		dm.logger.Error(
@@ -151,6 +176,140 @@ func (dm *DatabaseManager) InsertRow(ctx context.Context, query string, opName s
 	return id, err
 }
 
+// DeleteOne will delete a row using the given placeholder arguments and return the number of rows deleted.
+func (dm *DatabaseManager) DeleteOne(ctx context.Context, query string, opName string, args ...any) (int64, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, mysqlDeleteOne)
+	dm.addSpanTags(span, query)
+	defer span.Finish()
+	successStr := constants.True
+	// time database query
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.DatabaseOpDuration.WithLabelValues(dm.config.ServiceLabel, constants.Delete, opName, successStr).Observe(v)
+	}))
+	defer func() {
+		// observe duration at the end of this function
+		timer.ObserveDuration()
+	}()
+
+	stmt, err := dm.prepare(ctx, query, opName)
+	if err != nil {
+		successStr = constants.False
+		return 0, err
+	}
+
+	res, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		dm.logger.Error(
+			constants.ErrorDatabaseDeleteMsg,
+			zap.String(constants.Query, query),
+			zap.String(constants.OpName, opName),
+			zap.Error(err),
+		)
+		successStr = constants.False
+		return 0, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		successStr = constants.False
+		return 0, err
+	}
+
+	dm.logger.Info(
+		constants.InfoDatabaseDelete,
+		zap.String(constants.Query, query),
+		zap.Int64(constants.Count, rows),
+	)
+
+	return rows, err
+}
+
+// UpdateRows will update rows using the given placeholder arguments and return the number of rows updated.
+func (dm *DatabaseManager) UpdateRows(ctx context.Context, query string, opName string, args ...any) (int64, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, mysqlUpdateRow)
+	dm.addSpanTags(span, query)
+	defer span.Finish()
+	successStr := constants.True
+	// time database query
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.DatabaseOpDuration.WithLabelValues(dm.config.ServiceLabel, constants.Update, opName, successStr).Observe(v)
+	}))
+	defer func() {
+		// observe duration at the end of this function
+		timer.ObserveDuration()
+	}()
+
+	stmt, err := dm.prepare(ctx, query, opName)
+	if err != nil {
+		successStr = constants.False
+		return 0, err
+	}
+
+	res, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		dm.logger.Error(
+			constants.ErrorDatabaseUpdateMsg,
+			zap.String(constants.Query, query),
+			zap.String(constants.OpName, opName),
+			zap.Error(err),
+		)
+		successStr = constants.False
+		return 0, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		successStr = constants.False
+		return 0, err
+	}
+
+	dm.logger.Info(
+		constants.InfoDatabaseUpdate,
+		zap.String(constants.Query, query),
+		zap.Int64(constants.Count, rows),
+	)
+
+	return rows, err
+}
+
+// prepare returns the cached prepared statement for the query and opName, preparing and caching it on first use.
+// Concurrent first uses may each prepare the statement, and all but the cached one are closed.
+func (dm *DatabaseManager) prepare(ctx context.Context, query string, opName string) (*sql.Stmt, error) {
+	key := stmtKey{opName: opName, query: query}
+
+	dm.stmtsMu.RLock()
+	stmt, ok := dm.stmts[key]
+	dm.stmtsMu.RUnlock()
+	if ok {
+		return stmt, nil
+	}
+
+	// prepare outside the lock, so that a first use does not hold up every other query for a round trip
+	stmt, err := dm.db.PrepareContext(ctx, query)
+	if err != nil {
+		dm.logger.Error(
+			constants.ErrorDatabasePrepareMsg,
+			zap.String(constants.Query, query),
+			zap.String(constants.OpName, opName),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	dm.stmtsMu.Lock()
+	defer dm.stmtsMu.Unlock()
+	// another goroutine may have prepared the same statement meanwhile, keep the cached one
+	if cached, ok := dm.stmts[key]; ok {
+		stmt.Close()
+		return cached, nil
+	}
+	dm.stmts[key] = stmt
+
+	return stmt, nil
+}
+
 func (dm *DatabaseManager) addSpanTags(span ot.Span, statement string) {
 	span.SetTag(tracing.DatabaseType, tracing.DatabaseTypeSQL)
 	span.SetTag(tracing.DatabaseInstance, dm.config.DbName)
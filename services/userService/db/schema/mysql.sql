@@ -16,3 +16,16 @@ CREATE TABLE users (
  ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE INDEX username_pwd_idx ON users(username, password);
+
+DROP TABLE IF EXISTS sessions;
+
+CREATE TABLE sessions (
+    sessionID char(32) PRIMARY KEY,
+    userID bigint unsigned NOT NULL,
+    refreshHash binary(32) NOT NULL,
+    expiresAt bigint NOT NULL,
+    revoked boolean NOT NULL DEFAULT false,
+    timeCreated TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+ ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX userId_idx ON sessions(userID);
@@ -0,0 +1,11 @@
+package db
+
+// Session struct that defines the format of a user's login session that is stored in a database.
+// Only a hash of the session's refresh token is stored. The refresh token is replaced each time the session is refreshed.
+type Session struct {
+	SessionID   string
+	UserID      int64
+	RefreshHash []byte
+	ExpiresAt   int64
+	Revoked     bool
+}
@@ -0,0 +1,222 @@
+// Package keyring holds the keys that sign and verify JWT tokens.
+// Each key is identified by its kid, so that several keys can verify tokens while the signing key is rotated.
+package keyring
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	config "userService/config"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// AlgorithmRS256 signs tokens with an RSA key
+	AlgorithmRS256 = "RS256"
+	// AlgorithmEdDSA signs tokens with an Ed25519 key
+	AlgorithmEdDSA = "EdDSA"
+
+	// headerKeyID is the token header holding the ID of the key that signed the token
+	headerKeyID = "kid"
+)
+
+var (
+	// ErrUnknownKey is returned when a token was signed by a key that is not in the key ring
+	ErrUnknownKey = errors.New("keyring: unknown key")
+	// ErrAlgorithmMismatch is returned when a token's algorithm is not the algorithm of the key that signed it
+	ErrAlgorithmMismatch = errors.New("keyring: algorithm does not match key")
+)
+
+// Key is a key in the key ring. The private key is nil for a key that only verifies tokens.
+type Key struct {
+	ID         string
+	Algorithm  string
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+}
+
+// KeyRing signs tokens with its signing key, and verifies tokens signed by any of its keys.
+type KeyRing struct {
+	signing *Key
+	keys    map[string]*Key
+	// order is the order of the key IDs in the config, used to publish the keys
+	order []string
+}
+
+// Load reads the keys in the config from their PEM files and returns the key ring.
+// The signing key must be in the config, and have a private key.
+func Load(cfg *config.KeyRingConfig) (*KeyRing, error) {
+	r := &KeyRing{keys: make(map[string]*Key)}
+	for _, keyConfig := range cfg.Keys {
+		key, err := loadKey(keyConfig)
+		if err != nil {
+			return nil, fmt.Errorf("keyring: key %q: %w", keyConfig.ID, err)
+		}
+		if err := r.add(key); err != nil {
+			return nil, err
+		}
+	}
+
+	signing, ok := r.keys[cfg.SigningKey]
+	if !ok {
+		return nil, fmt.Errorf("keyring: signing key %q: %w", cfg.SigningKey, ErrUnknownKey)
+	}
+	if signing.PrivateKey == nil {
+		return nil, fmt.Errorf("keyring: signing key %q has no private key", cfg.SigningKey)
+	}
+	r.signing = signing
+	return r, nil
+}
+
+// add adds the key to the key ring.
+func (r *KeyRing) add(key *Key) error {
+	if key.ID == "" {
+		return errors.New("keyring: key has no ID")
+	}
+	if _, ok := r.keys[key.ID]; ok {
+		return fmt.Errorf("keyring: duplicate key %q", key.ID)
+	}
+	r.keys[key.ID] = key
+	r.order = append(r.order, key.ID)
+	return nil
+}
+
+// Sign returns the token with the claims, signed by the signing key. The token's kid header is the signing key's ID.
+func (r *KeyRing) Sign(claims jwt.Claims) (string, error) {
+	if r.signing == nil {
+		return "", errors.New("keyring: no signing key")
+	}
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(r.signing.Algorithm), claims)
+	token.Header[headerKeyID] = r.signing.ID
+	return token.SignedString(r.signing.PrivateKey)
+}
+
+// Keyfunc returns the public key that verifies the token, found by the token's kid header.
+// The token must use the key's algorithm, so that a token cannot be verified with a key of another algorithm.
+func (r *KeyRing) Keyfunc(token *jwt.Token) (interface{}, error) {
+	id, _ := token.Header[headerKeyID].(string)
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	if token.Method == nil || token.Method.Alg() != key.Algorithm {
+		return nil, ErrAlgorithmMismatch
+	}
+	return key.PublicKey, nil
+}
+
+// Methods returns the algorithms of the keys in the key ring, used to reject tokens with any other algorithm when parsing.
+func (r *KeyRing) Methods() []string {
+	seen := make(map[string]bool)
+	var methods []string
+	for _, id := range r.order {
+		alg := r.keys[id].Algorithm
+		if !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+	return methods
+}
+
+// JWK is a public key in the JSON Web Key format, as defined in RFC 7517.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	// N and E are the modulus and exponent of an RSA key
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Curve and X are the curve and public key of an Ed25519 key
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JWKS is a set of public keys in the JSON Web Key Set format, as defined in RFC 7517.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys of the key ring, in the order of the config.
+func (r *KeyRing) JWKS() JWKS {
+	set := JWKS{Keys: make([]JWK, 0, len(r.order))}
+	for _, id := range r.order {
+		key := r.keys[id]
+		jwk := JWK{KeyID: key.ID, Algorithm: key.Algorithm, Use: "sig"}
+		switch pub := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+// loadKey reads the key's PEM files. The public key is taken from the private key if there is no public key file.
+func loadKey(cfg config.KeyConfig) (*Key, error) {
+	key := &Key{ID: cfg.ID, Algorithm: cfg.Algorithm}
+	if cfg.PrivateKeyFile != "" {
+		b, err := os.ReadFile(cfg.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		key.PrivateKey, err = ParsePrivateKey(cfg.Algorithm, b)
+		if err != nil {
+			return nil, err
+		}
+		key.PublicKey = key.PrivateKey.Public()
+	}
+	if cfg.PublicKeyFile != "" {
+		b, err := os.ReadFile(cfg.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		key.PublicKey, err = ParsePublicKey(cfg.Algorithm, b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if key.PublicKey == nil {
+		return nil, errors.New("no private or public key file")
+	}
+	return key, nil
+}
+
+// ParsePrivateKey parses a PEM encoded private key for the algorithm.
+func ParsePrivateKey(algorithm string, b []byte) (crypto.Signer, error) {
+	switch algorithm {
+	case AlgorithmRS256:
+		return jwt.ParseRSAPrivateKeyFromPEM(b)
+	case AlgorithmEdDSA:
+		key, err := jwt.ParseEdPrivateKeyFromPEM(b)
+		if err != nil {
+			return nil, err
+		}
+		return key.(crypto.Signer), nil
+	}
+	return nil, fmt.Errorf("unsupported algorithm %q", algorithm)
+}
+
+// ParsePublicKey parses a PEM encoded public key for the algorithm.
+func ParsePublicKey(algorithm string, b []byte) (crypto.PublicKey, error) {
+	switch algorithm {
+	case AlgorithmRS256:
+		return jwt.ParseRSAPublicKeyFromPEM(b)
+	case AlgorithmEdDSA:
+		return jwt.ParseEdPublicKeyFromPEM(b)
+	}
+	return nil, fmt.Errorf("unsupported algorithm %q", algorithm)
+}
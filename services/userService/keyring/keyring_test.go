@@ -0,0 +1,114 @@
+package keyring
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	config "userService/config"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// writePEM writes the PEM block to a file in dir and returns the file's path.
+func writePEM(t *testing.T, dir string, name string, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+// testKeyRing returns a key ring that signs with an Ed25519 key, and verifies with it and the public key of an older RSA key.
+// It also returns the PEM encoding of the RSA public key.
+func testKeyRing(t *testing.T) (*KeyRing, []byte) {
+	t.Helper()
+	dir := t.TempDir()
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	edDER, _ := x509.MarshalPKCS8PrivateKey(edKey)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	rsaDER, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+
+	keys, err := Load(&config.KeyRingConfig{
+		SigningKey: "new",
+		Keys: []config.KeyConfig{
+			{ID: "old", Algorithm: AlgorithmRS256, PublicKeyFile: writePEM(t, dir, "old.pem", "PUBLIC KEY", rsaDER)},
+			{ID: "new", Algorithm: AlgorithmEdDSA, PrivateKeyFile: writePEM(t, dir, "new.pem", "PRIVATE KEY", edDER)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	return keys, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: rsaDER})
+}
+
+func TestKeyRingSignAndVerify(t *testing.T) {
+	keys, _ := testKeyRing(t)
+
+	tokenString, err := keys.Sign(&jwt.RegisteredClaims{Subject: "1", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))})
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	claims := &jwt.RegisteredClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, keys.Keyfunc, jwt.WithValidMethods(keys.Methods()))
+	if err != nil || !token.Valid || claims.Subject != "1" {
+		t.Fatalf("Parse: got (%v, %v), want a valid token", claims, err)
+	}
+	if kid := token.Header[headerKeyID]; kid != "new" {
+		t.Errorf("kid: got %v, want the signing key", kid)
+	}
+}
+
+func TestKeyRingRejectsAlgorithmConfusion(t *testing.T) {
+	keys, rsaPublicPEM := testKeyRing(t)
+
+	// an HS256 token signed with the RSA public key as the secret must not verify with the RSA key
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.RegisteredClaims{Subject: "1"})
+	token.Header[headerKeyID] = "old"
+	forged, err := token.SignedString(rsaPublicPEM)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+	if _, err := jwt.Parse(forged, keys.Keyfunc); !errors.Is(err, ErrAlgorithmMismatch) {
+		t.Errorf("Parse forged token: got %v, want ErrAlgorithmMismatch", err)
+	}
+
+	// a token signed by a key that is not in the key ring must not verify
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	token = jwt.NewWithClaims(jwt.SigningMethodEdDSA, &jwt.RegisteredClaims{Subject: "1"})
+	token.Header[headerKeyID] = "other"
+	unknown, _ := token.SignedString(otherKey)
+	if _, err := jwt.Parse(unknown, keys.Keyfunc); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Parse token of unknown key: got %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestKeyRingJWKS(t *testing.T) {
+	keys, _ := testKeyRing(t)
+
+	jwks := keys.JWKS()
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("JWKS: got %d keys, want 2", len(jwks.Keys))
+	}
+	if k := jwks.Keys[0]; k.KeyID != "old" || k.KeyType != "RSA" || k.Algorithm != AlgorithmRS256 || k.N == "" || k.E != "AQAB" {
+		t.Errorf("JWKS RSA key: got %+v", k)
+	}
+	if k := jwks.Keys[1]; k.KeyID != "new" || k.KeyType != "OKP" || k.Curve != "Ed25519" || k.Algorithm != AlgorithmEdDSA || k.X == "" {
+		t.Errorf("JWKS Ed25519 key: got %+v", k)
+	}
+}
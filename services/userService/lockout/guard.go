@@ -0,0 +1,212 @@
+package lockout
+
+import (
+	"context"
+	"time"
+	"userService/config"
+	constants "userService/constants"
+	metrics "userService/metrics"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// userKeyPrefix prefixes the key of a username's failed attempts
+	userKeyPrefix = "login:user:"
+	// ipKeyPrefix prefixes the key of a client IP's failed attempts
+	ipKeyPrefix = "login:ip:"
+	// maxDelayShift bounds the doubling of the delay, so that it cannot overflow
+	maxDelayShift = 30
+)
+
+// Guard limits failed login attempts. After each failed attempt for a username, the next attempt is delayed,
+// doubling with each further failure. A username or client IP with too many failed attempts in the window is locked.
+// Errors from the store are logged and the attempt is allowed, so that logins do not depend on the store being available.
+type Guard struct {
+	store  Store
+	config *config.LoginGuardConfig
+	label  string
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+// NewGuard returns a Guard that counts failed attempts in the store.
+func NewGuard(store Store, cfg *config.LoginGuardConfig, serviceLabel string, logger *zap.Logger) *Guard {
+	return &Guard{
+		store:  store,
+		config: cfg,
+		label:  serviceLabel,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Attempt is a login attempt reserved by Begin. It is counted as failed until Succeed or Release is called.
+type Attempt struct {
+	username string
+	clientIP string
+	now      time.Time
+	// failures and ipFailures are the failed attempts in the window including this one, or 0 if they were not counted
+	failures   int64
+	ipFailures int64
+	// failureID and ipFailureID identify this attempt in the username's and client IP's failed attempts
+	failureID   string
+	ipFailureID string
+}
+
+// Begin reserves a login attempt for the username from the client IP before the password is checked.
+// The attempt is counted as failed up front, so that concurrent attempts cannot all pass before any of them is recorded.
+// It returns the reserved attempt if it is allowed now. Otherwise it returns nil and how long until an attempt is allowed,
+// as the username or client IP is delayed or locked, or has no attempts left in the window.
+func (g *Guard) Begin(ctx context.Context, username string, clientIP string) (*Attempt, time.Duration) {
+	now := g.now()
+	if wait := g.lockedFor(ctx, username, clientIP, now); wait > 0 {
+		return nil, wait
+	}
+
+	window := time.Duration(g.config.Window) * time.Second
+	lockout := time.Duration(g.config.Lockout) * time.Second
+	attempt := &Attempt{username: username, clientIP: clientIP, now: now}
+
+	key := userKeyPrefix + username
+	id, failures, err := g.store.AddFailure(ctx, key, now, window)
+	if err != nil {
+		g.logger.Error(constants.ErrorLoginGuardMsg, zap.String(constants.Key, key), zap.Error(err))
+	} else if g.config.MaxFailures > 0 && failures > int64(g.config.MaxFailures) {
+		// a concurrent attempt used the last one, and locks the username when it fails
+		return nil, lockout
+	} else {
+		attempt.failures = failures
+		attempt.failureID = id
+	}
+
+	if clientIP == "" {
+		return attempt, 0
+	}
+	key = ipKeyPrefix + clientIP
+	id, failures, err = g.store.AddFailure(ctx, key, now, window)
+	if err != nil {
+		g.logger.Error(constants.ErrorLoginGuardMsg, zap.String(constants.Key, key), zap.Error(err))
+	} else if g.config.MaxIPFailures > 0 && failures > int64(g.config.MaxIPFailures) {
+		// the attempt is not made, so it does not count against the username, which may not be the client's
+		g.removeFailure(ctx, userKeyPrefix+username, attempt.failureID)
+		return nil, lockout
+	} else {
+		attempt.ipFailures = failures
+		attempt.ipFailureID = id
+	}
+	return attempt, 0
+}
+
+// Fail delays or locks the username and client IP of a failed attempt, which Begin has already counted.
+// It returns how long until the next attempt for the username from the client IP is allowed.
+func (g *Guard) Fail(ctx context.Context, attempt *Attempt) time.Duration {
+	metrics.FailedLogins.WithLabelValues(g.label).Inc()
+
+	now := attempt.now
+	lockout := time.Duration(g.config.Lockout) * time.Second
+	var wait time.Duration
+
+	// the username is delayed after each failure, and locked after too many
+	key := userKeyPrefix + attempt.username
+	if failures := attempt.failures; failures > 0 {
+		delay := g.delay(failures)
+		if g.config.MaxFailures > 0 && failures >= int64(g.config.MaxFailures) {
+			delay = lockout
+			metrics.Lockouts.WithLabelValues(g.label, constants.Account).Inc()
+			g.logger.Warn(constants.InfoAccountLocked, zap.String(constants.Username, attempt.username), zap.Int64(constants.Count, failures))
+		}
+		if delay > 0 {
+			if err := g.store.Lock(ctx, key, now, now.Add(delay)); err != nil {
+				g.logger.Error(constants.ErrorLoginGuardMsg, zap.String(constants.Key, key), zap.Error(err))
+			} else {
+				wait = delay
+			}
+		}
+	}
+
+	// the client IP is locked after too many failures, across usernames
+	failures := attempt.ipFailures
+	if failures == 0 {
+		return wait
+	}
+	key = ipKeyPrefix + attempt.clientIP
+	if g.config.MaxIPFailures > 0 && failures >= int64(g.config.MaxIPFailures) {
+		metrics.Lockouts.WithLabelValues(g.label, constants.ClientIP).Inc()
+		g.logger.Warn(constants.InfoClientIPLocked, zap.String(constants.ClientIP, attempt.clientIP), zap.Int64(constants.Count, failures))
+		if err := g.store.Lock(ctx, key, now, now.Add(lockout)); err != nil {
+			g.logger.Error(constants.ErrorLoginGuardMsg, zap.String(constants.Key, key), zap.Error(err))
+		} else if lockout > wait {
+			wait = lockout
+		}
+	}
+	return wait
+}
+
+// Succeed forgets the failed attempts for the username after a successful login,
+// and takes back the attempt reserved for the client IP.
+// The other failed attempts from the client IP are kept, so that guessing other usernames is still limited.
+func (g *Guard) Succeed(ctx context.Context, attempt *Attempt) {
+	key := userKeyPrefix + attempt.username
+	if err := g.store.Reset(ctx, key); err != nil {
+		g.logger.Error(constants.ErrorLoginGuardMsg, zap.String(constants.Key, key), zap.Error(err))
+	}
+	g.removeFailure(ctx, ipKeyPrefix+attempt.clientIP, attempt.ipFailureID)
+}
+
+// Release takes back an attempt that could not be checked, e.g. because of a database error,
+// so that it is counted as failed against neither the username nor the client IP.
+func (g *Guard) Release(ctx context.Context, attempt *Attempt) {
+	g.removeFailure(ctx, userKeyPrefix+attempt.username, attempt.failureID)
+	g.removeFailure(ctx, ipKeyPrefix+attempt.clientIP, attempt.ipFailureID)
+}
+
+// removeFailure removes the failed attempt with the ID from the key's failed attempts, if it was recorded.
+func (g *Guard) removeFailure(ctx context.Context, key string, id string) {
+	if id == "" {
+		return
+	}
+	if err := g.store.RemoveFailure(ctx, key, id); err != nil {
+		g.logger.Error(constants.ErrorLoginGuardMsg, zap.String(constants.Key, key), zap.Error(err))
+	}
+}
+
+// lockedFor returns how long until a login attempt for the username from the client IP is allowed, or 0 if it is allowed now.
+func (g *Guard) lockedFor(ctx context.Context, username string, clientIP string, now time.Time) time.Duration {
+	var wait time.Duration
+	for _, key := range keys(username, clientIP) {
+		until, err := g.store.LockedUntil(ctx, key, now)
+		if err != nil {
+			g.logger.Error(constants.ErrorLoginGuardMsg, zap.String(constants.Key, key), zap.Error(err))
+			continue
+		}
+		if d := until.Sub(now); d > wait {
+			wait = d
+		}
+	}
+	return wait
+}
+
+// delay returns the time before another attempt after the given number of failed attempts.
+func (g *Guard) delay(failures int64) time.Duration {
+	if failures < 1 || g.config.BaseDelay <= 0 {
+		return 0
+	}
+	shift := failures - 1
+	if shift > maxDelayShift {
+		shift = maxDelayShift
+	}
+	delay := time.Duration(g.config.BaseDelay) * time.Millisecond << shift
+	if maxDelay := time.Duration(g.config.MaxDelay) * time.Second; delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
+
+// keys returns the keys that must not be locked for a login attempt for the username from the client IP.
+func keys(username string, clientIP string) []string {
+	if clientIP == "" {
+		return []string{userKeyPrefix + username}
+	}
+	return []string{userKeyPrefix + username, ipKeyPrefix + clientIP}
+}
@@ -0,0 +1,188 @@
+package lockout
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+	"userService/config"
+
+	"go.uber.org/zap"
+)
+
+// testGuard returns a guard with an in-memory store, and a clock that the test moves forward.
+func testGuard() (*Guard, *time.Time) {
+	cfg := &config.LoginGuardConfig{
+		Window:        60,
+		MaxFailures:   4,
+		MaxIPFailures: 6,
+		Lockout:       300,
+		BaseDelay:     1000,
+		MaxDelay:      3,
+	}
+	now := time.Unix(1660000000, 0)
+	guard := NewGuard(NewMemoryStore(), cfg, "test", zap.NewNop())
+	guard.now = func() time.Time { return now }
+	return guard, &now
+}
+
+// fail begins an attempt and fails it, and returns how long until the next attempt is allowed.
+func fail(t *testing.T, guard *Guard, username string, clientIP string) time.Duration {
+	t.Helper()
+	attempt, wait := guard.Begin(context.Background(), username, clientIP)
+	if attempt == nil {
+		t.Fatalf("Begin for %s from %s rejected, retry after %v", username, clientIP, wait)
+	}
+	return guard.Fail(context.Background(), attempt)
+}
+
+// lockedFor returns how long until an attempt for the username from the client IP is allowed, without reserving one.
+func lockedFor(guard *Guard, username string, clientIP string) time.Duration {
+	return guard.lockedFor(context.Background(), username, clientIP, guard.now())
+}
+
+func TestGuardDelaysAndLocksAccount(t *testing.T) {
+	guard, now := testGuard()
+
+	// each failure doubles the delay, up to the max delay
+	for i, want := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second} {
+		if wait := fail(t, guard, "alice", "10.0.0.1"); wait != want {
+			t.Fatalf("attempt %d: Fail got %v, want %v", i+1, wait, want)
+		}
+		if attempt, wait := guard.Begin(context.Background(), "alice", "10.0.0.1"); attempt != nil || wait != want {
+			t.Fatalf("attempt %d: Begin got %v, want rejected for %v", i+1, wait, want)
+		}
+		*now = now.Add(want)
+	}
+
+	// the fourth failure locks the account, from any client IP
+	if wait := fail(t, guard, "alice", "10.0.0.1"); wait != 300*time.Second {
+		t.Fatalf("Fail got %v, want lockout", wait)
+	}
+	if wait := lockedFor(guard, "alice", "10.0.0.2"); wait != 300*time.Second {
+		t.Fatalf("lockedFor from another client IP got %v, want lockout", wait)
+	}
+	if wait := lockedFor(guard, "bob", "10.0.0.2"); wait != 0 {
+		t.Fatalf("lockedFor for another username got %v, want 0", wait)
+	}
+
+	*now = now.Add(300 * time.Second)
+	if wait := lockedFor(guard, "alice", "10.0.0.1"); wait != 0 {
+		t.Fatalf("lockedFor after lockout got %v, want 0", wait)
+	}
+}
+
+func TestGuardFailuresExpireWithWindow(t *testing.T) {
+	guard, now := testGuard()
+
+	fail(t, guard, "alice", "")
+	*now = now.Add(time.Second)
+	fail(t, guard, "alice", "")
+	*now = now.Add(61 * time.Second)
+
+	// the earlier failures are outside the window, so this is the first failure again
+	if wait := fail(t, guard, "alice", ""); wait != time.Second {
+		t.Fatalf("Fail got %v, want %v", wait, time.Second)
+	}
+}
+
+func TestGuardSucceedResetsUsernameButNotClientIP(t *testing.T) {
+	ctx := context.Background()
+	guard, now := testGuard()
+
+	for i := 0; i < 3; i++ {
+		fail(t, guard, "alice", "10.0.0.1")
+		*now = now.Add(5 * time.Second)
+	}
+	attempt, _ := guard.Begin(ctx, "alice", "10.0.0.1")
+	guard.Succeed(ctx, attempt)
+	if wait := fail(t, guard, "alice", "10.0.0.1"); wait != time.Second {
+		t.Fatalf("Fail after success got %v, want %v", wait, time.Second)
+	}
+
+	// failures from the client IP are counted across usernames, but the successful attempt is not
+	*now = now.Add(time.Second)
+	fail(t, guard, "bob", "10.0.0.1")
+	if wait := fail(t, guard, "carol", "10.0.0.1"); wait != 300*time.Second {
+		t.Fatalf("Fail got %v, want client IP lockout", wait)
+	}
+	if wait := lockedFor(guard, "dave", "10.0.0.1"); wait != 300*time.Second {
+		t.Fatalf("lockedFor from locked client IP got %v, want lockout", wait)
+	}
+	if wait := lockedFor(guard, "dave", "10.0.0.2"); wait != 0 {
+		t.Fatalf("lockedFor from another client IP got %v, want 0", wait)
+	}
+}
+
+func TestGuardLimitsConcurrentAttempts(t *testing.T) {
+	ctx := context.Background()
+	guard, _ := testGuard()
+
+	// all the attempts begin before any of them fails, as when the password checks run concurrently
+	const attempts = 20
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		allowed []*Attempt
+	)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if attempt, _ := guard.Begin(ctx, "alice", ""); attempt != nil {
+				mu.Lock()
+				allowed = append(allowed, attempt)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(allowed) != 4 {
+		t.Fatalf("Begin allowed %d concurrent attempts, want 4", len(allowed))
+	}
+	for _, attempt := range allowed {
+		guard.Fail(ctx, attempt)
+	}
+	if wait := lockedFor(guard, "alice", ""); wait != 300*time.Second {
+		t.Fatalf("lockedFor after concurrent failures got %v, want lockout", wait)
+	}
+}
+
+func TestGuardReleaseDoesNotCountTheAttempt(t *testing.T) {
+	ctx := context.Background()
+	guard, _ := testGuard()
+
+	// attempts that cannot be checked, e.g. during a database outage, do not lock the username or client IP
+	for i := 0; i < 10; i++ {
+		attempt, wait := guard.Begin(ctx, "alice", "10.0.0.1")
+		if attempt == nil {
+			t.Fatalf("attempt %d: Begin rejected, retry after %v", i+1, wait)
+		}
+		guard.Release(ctx, attempt)
+	}
+	if wait := fail(t, guard, "alice", "10.0.0.1"); wait != time.Second {
+		t.Fatalf("Fail after released attempts got %v, want %v", wait, time.Second)
+	}
+}
+
+func TestGuardClientIPRejectionDoesNotCountAgainstUsername(t *testing.T) {
+	ctx := context.Background()
+	guard, _ := testGuard()
+
+	// the client IP's attempts are all in flight, so the next one is rejected before the client IP is locked
+	for i := 0; i < 6; i++ {
+		if attempt, _ := guard.Begin(ctx, "user"+strconv.Itoa(i), "10.0.0.1"); attempt == nil {
+			t.Fatalf("attempt %d: Begin rejected", i+1)
+		}
+	}
+	if attempt, _ := guard.Begin(ctx, "alice", "10.0.0.1"); attempt != nil {
+		t.Fatalf("Begin over the client IP limit was allowed")
+	}
+
+	// the rejected attempt is not counted for the username
+	if wait := fail(t, guard, "alice", "10.0.0.2"); wait != time.Second {
+		t.Errorf("Fail from another client IP got %v, want the first failure's %v", wait, time.Second)
+	}
+}
@@ -0,0 +1,120 @@
+package lockout
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// sweepInterval is the number of failed attempts recorded between sweeps of the keys that are no longer needed
+const sweepInterval = 1000
+
+// MemoryStore keeps failed attempts and locks in this process. It is safe for concurrent use.
+// The counts are not shared between user service instances, and are lost on restart.
+type MemoryStore struct {
+	mu       sync.Mutex
+	failures map[string][]failure
+	locks    map[string]time.Time
+	added    int
+}
+
+// failure is a failed attempt recorded by a MemoryStore.
+type failure struct {
+	id string
+	at time.Time
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		failures: make(map[string][]failure),
+		locks:    make(map[string]time.Time),
+	}
+}
+
+// AddFailure records a failed attempt for the key, and returns its ID and the number of failed attempts in the window.
+func (m *MemoryStore) AddFailure(ctx context.Context, key string, now time.Time, window time.Duration) (string, int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.added++
+	id := strconv.Itoa(m.added)
+	failures := append(inWindow(m.failures[key], now, window), failure{id: id, at: now})
+	m.failures[key] = failures
+
+	if m.added%sweepInterval == 0 {
+		m.sweep(now, window)
+	}
+	return id, int64(len(failures)), nil
+}
+
+// RemoveFailure removes the failed attempt with the ID from the key's failed attempts.
+func (m *MemoryStore) RemoveFailure(ctx context.Context, key string, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	failures := m.failures[key]
+	for i := range failures {
+		if failures[i].id == id {
+			m.failures[key] = append(failures[:i:i], failures[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+// Lock locks the key until the given time.
+func (m *MemoryStore) Lock(ctx context.Context, key string, now time.Time, until time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.locks[key] = until
+	return nil
+}
+
+// LockedUntil returns the time the key is locked until, or the zero time if it is not locked.
+func (m *MemoryStore) LockedUntil(ctx context.Context, key string, now time.Time) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	until, ok := m.locks[key]
+	if !ok || !until.After(now) {
+		return time.Time{}, nil
+	}
+	return until, nil
+}
+
+// Reset removes the failed attempts and the lock of the key.
+func (m *MemoryStore) Reset(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.failures, key)
+	delete(m.locks, key)
+	return nil
+}
+
+// sweep removes the keys without failed attempts in the window, and the expired locks. The lock must be held.
+func (m *MemoryStore) sweep(now time.Time, window time.Duration) {
+	for key, failures := range m.failures {
+		if failures = inWindow(failures, now, window); len(failures) == 0 {
+			delete(m.failures, key)
+		} else {
+			m.failures[key] = failures
+		}
+	}
+	for key, until := range m.locks {
+		if !until.After(now) {
+			delete(m.locks, key)
+		}
+	}
+}
+
+// inWindow returns the failed attempts, in the order they were recorded, that are in the window ending at now.
+func inWindow(failures []failure, now time.Time, window time.Duration) []failure {
+	start := now.Add(-window)
+	i := 0
+	for i < len(failures) && !failures[i].at.After(start) {
+		i++
+	}
+	return failures[i:]
+}
@@ -0,0 +1,89 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+	"userService/config"
+	constants "userService/constants"
+
+	redis "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// RedisStore keeps failed attempts and locks in redis, so that they are shared between user service instances.
+// The failed attempts of a key are a sorted set scored by the time of each attempt, and a lock is a key that expires with it.
+type RedisStore struct {
+	client *redis.Client
+}
+
+// InitRedisStore creates the redis client, and tests the connection.
+func InitRedisStore(redisConfig *config.RedisConfig, logger *zap.Logger) (*RedisStore, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%s", redisConfig.Host, redisConfig.Port),
+		Password: redisConfig.Password,
+		DB:       redisConfig.Db,
+	})
+
+	pong, err := client.Ping(context.Background()).Result()
+	if err != nil {
+		logger.Error(constants.ErrorRedisConnectionMsg, zap.Error(err))
+		return nil, err
+	}
+	logger.Info(constants.InfoRedisConnectSuccess, zap.String("pong", pong))
+
+	return &RedisStore{client: client}, nil
+}
+
+// AddFailure records a failed attempt for the key, and returns its ID and the number of failed attempts in the window.
+// The attempts are trimmed, added and counted in a single transaction.
+func (r *RedisStore) AddFailure(ctx context.Context, key string, now time.Time, window time.Duration) (string, int64, error) {
+	failures := key + failuresSuffix
+	// attempts at the same millisecond are kept apart by a random suffix
+	member := strconv.FormatInt(now.UnixNano(), 10) + "-" + strconv.FormatInt(rand.Int63(), 36)
+
+	var count *redis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, failures, "-inf", strconv.FormatInt(now.Add(-window).UnixMilli(), 10))
+		pipe.ZAdd(ctx, failures, &redis.Z{Score: float64(now.UnixMilli()), Member: member})
+		count = pipe.ZCard(ctx, failures)
+		pipe.PExpire(ctx, failures, window)
+		return nil
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	return member, count.Val(), nil
+}
+
+// RemoveFailure removes the failed attempt with the ID from the key's failed attempts.
+func (r *RedisStore) RemoveFailure(ctx context.Context, key string, id string) error {
+	return r.client.ZRem(ctx, key+failuresSuffix, id).Err()
+}
+
+// Lock locks the key until the given time.
+func (r *RedisStore) Lock(ctx context.Context, key string, now time.Time, until time.Time) error {
+	return r.client.Set(ctx, key+lockSuffix, until.UnixMilli(), until.Sub(now)).Err()
+}
+
+// LockedUntil returns the time the key is locked until, or the zero time if it is not locked.
+func (r *RedisStore) LockedUntil(ctx context.Context, key string, now time.Time) (time.Time, error) {
+	until, err := r.client.Get(ctx, key+lockSuffix).Int64()
+	if err != nil {
+		if err == redis.Nil {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	if lockedUntil := time.UnixMilli(until); lockedUntil.After(now) {
+		return lockedUntil, nil
+	}
+	return time.Time{}, nil
+}
+
+// Reset removes the failed attempts and the lock of the key.
+func (r *RedisStore) Reset(ctx context.Context, key string) error {
+	return r.client.Del(ctx, key+failuresSuffix, key+lockSuffix).Err()
+}
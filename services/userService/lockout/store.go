@@ -0,0 +1,45 @@
+// Package lockout limits failed login attempts per username and per client IP.
+// Failed attempts are counted in a sliding window by a Store, kept in memory or in redis.
+package lockout
+
+import (
+	"context"
+	"time"
+	"userService/config"
+	constants "userService/constants"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// failuresSuffix is appended to a key to store its failed attempts
+	failuresSuffix = ":failures"
+	// lockSuffix is appended to a key to store the time it is locked until
+	lockSuffix = ":lock"
+)
+
+// Store keeps the failed attempts and locks of keys, such as a username or a client IP.
+type Store interface {
+	// AddFailure records a failed attempt for the key at now, forgets the attempts older than the window,
+	// and returns the ID of the attempt and the number of failed attempts in the window, including it.
+	// The attempt is recorded and counted atomically, so that concurrent attempts get distinct counts.
+	AddFailure(ctx context.Context, key string, now time.Time, window time.Duration) (string, int64, error)
+	// RemoveFailure removes the failed attempt with the ID from the key's failed attempts.
+	RemoveFailure(ctx context.Context, key string, id string) error
+	// Lock locks the key until the given time.
+	Lock(ctx context.Context, key string, now time.Time, until time.Time) error
+	// LockedUntil returns the time the key is locked until, or the zero time if it is not locked at now.
+	LockedUntil(ctx context.Context, key string, now time.Time) (time.Time, error)
+	// Reset removes the failed attempts and the lock of the key.
+	Reset(ctx context.Context, key string) error
+}
+
+// InitStore returns the Store for the backend set in the login guard config.
+// The in-memory store is used unless the redis backend is selected.
+func InitStore(cfg *config.LoginGuardConfig, logger *zap.Logger) (Store, error) {
+	if cfg.Backend == constants.Redis {
+		return InitRedisStore(&cfg.RedisConfig, logger)
+	}
+	logger.Info(constants.InfoLoginGuardMemoryBackend)
+	return NewMemoryStore(), nil
+}
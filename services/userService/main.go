@@ -5,6 +5,8 @@ import (
 	"userService/config"
 	"userService/constants"
 	"userService/db"
+	"userService/keyring"
+	"userService/lockout"
 
 	jaegerTracer "userService/tracing"
 
@@ -37,6 +39,20 @@ func main() {
 		panic(err)
 	}
 
+	// load the keys that sign tokens
+	keys, err := keyring.Load(&config.KeyRingConfig)
+	if err != nil {
+		logger.Fatal(constants.ErrorLoadKeyRingMsg, zap.Error(err))
+		panic(err)
+	}
+
+	// count failed login attempts in the store for the configured backend
+	loginStore, err := lockout.InitStore(&config.LoginGuardConfig, logger)
+	if err != nil {
+		panic(err)
+	}
+	guard := lockout.NewGuard(loginStore, &config.LoginGuardConfig, config.ServiceLabel, logger)
+
 	// init jaeger
 	tracer, closer, err := jaegerTracer.InitJaeger(&config.JaegerConfig, logger)
 	if err != nil {
@@ -50,7 +66,7 @@ func main() {
 	server := server.Server{}
 
 	// start grpc server
-	server.StartServer(config, dbManager, logger, tracer)
+	server.StartServer(config, dbManager, keys, guard, logger, tracer)
 }
 
 func newLogger() (*zap.Logger, error) {
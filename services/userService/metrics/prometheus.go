@@ -16,6 +16,10 @@ var (
 	RequestDuration *prometheus.HistogramVec
 	// DatabaseOpDuration tracks database op durations.
 	DatabaseOpDuration *prometheus.HistogramVec
+	// FailedLogins counts failed login attempts.
+	FailedLogins *prometheus.CounterVec
+	// Lockouts counts the accounts and client IPs locked after too many failed login attempts.
+	Lockouts *prometheus.CounterVec
 )
 
 func init() {
@@ -41,6 +45,22 @@ func init() {
 		[]string{"service_label", "query_type", "query_label", "success"},
 	)
 
+	FailedLogins = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "process_failed_logins_total",
+			Help: "Counts the failed login attempts",
+		},
+		[]string{"service_label"},
+	)
+
+	Lockouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "process_login_lockouts_total",
+			Help: "Counts the accounts and client IPs locked after too many failed login attempts",
+		},
+		[]string{"service_label", "scope"},
+	)
+
 	// register collectors
-	Reg.MustRegister(GrpcMetrics, RequestDuration, DatabaseOpDuration)
+	Reg.MustRegister(GrpcMetrics, RequestDuration, DatabaseOpDuration, FailedLogins, Lockouts)
 }
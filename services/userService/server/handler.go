@@ -3,11 +3,13 @@ package server
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"time"
 	"userService/config"
 	constants "userService/constants"
 	db "userService/db"
 	customErr "userService/errors"
+	"userService/keyring"
+	"userService/lockout"
 
 	"go.uber.org/zap"
 
@@ -24,6 +26,8 @@ const (
 type Handler struct {
 	config    *config.Config
 	dbManager *db.DatabaseManager
+	keys      *keyring.KeyRing
+	guard     *lockout.Guard
 	logger    *zap.Logger
 }
 
@@ -79,20 +83,41 @@ func (h *Handler) CreateNewUser(ctx context.Context, username string, password s
 // Verifies the user's given password gainst the hash in the database,
 // and returns the userID if passwords match.
 // Else, returns an error.
-func (h *Handler) VerifyLogin(ctx context.Context, username string, password string) (int64, error) {
+// Attempts are rejected while the username or client IP is delayed or locked after failed attempts,
+// and the time until another attempt is allowed is returned with the error.
+// The attempt is counted as failed before the password is checked, so that concurrent attempts are limited too.
+func (h *Handler) VerifyLogin(ctx context.Context, username string, password string, clientIP string) (int64, time.Duration, error) {
+	// reject the attempt before checking the password if the username or client IP is delayed or locked
+	attempt, retryAfter := h.guard.Begin(ctx, username, clientIP)
+	if attempt == nil {
+		h.logger.Info(
+			constants.ErrorTooManyLoginAttemptsMsg,
+			zap.String(constants.Username, username),
+			zap.String(constants.ClientIP, clientIP),
+			zap.Duration(constants.RetryAfter, retryAfter),
+		)
+		return 0, retryAfter, &customErr.Error{
+			ErrorCode: constants.ErrorTooManyLoginAttempts,
+			ErrorMsg:  constants.ErrorTooManyLoginAttemptsMsg,
+		}
+	}
+
 	// retrieve the user
 	exists, user, err := h.checkUserExists(ctx, username)
 	if err != nil {
 		// error occured when querying database
-		return 0, &customErr.Error{
+		// the attempt was not checked, so it is not counted as failed
+		h.guard.Release(ctx, attempt)
+		return 0, 0, &customErr.Error{
 			ErrorCode: constants.ErrorDatabaseQuery,
 			ErrorMsg:  constants.ErrorDatabaseQueryMsg,
 		}
 	}
 
 	// user does not exist, return error
+	// the attempt counts as failed, so that unknown usernames are limited like wrong passwords
 	if !exists {
-		return 0, &customErr.Error{
+		return 0, h.guard.Fail(ctx, attempt), &customErr.Error{
 			ErrorCode: constants.ErrorUserDoesNotExist,
 		}
 	}
@@ -106,10 +131,11 @@ func (h *Handler) VerifyLogin(ctx context.Context, username string, password str
 			zap.String(constants.Username, username),
 			zap.Error(err),
 		)
-		return 0, &customErr.Error{
+		return 0, h.guard.Fail(ctx, attempt), &customErr.Error{
 			ErrorCode: constants.ErrorUserPassword,
 		}
 	}
+	h.guard.Succeed(ctx, attempt)
 
 	// log successful login
 	h.logger.Info(
@@ -119,7 +145,62 @@ func (h *Handler) VerifyLogin(ctx context.Context, username string, password str
 	)
 
 	// return userID
-	return user.UserID, err
+	return user.UserID, 0, err
+}
+
+// DeleteAccount is called by the server when it receives a request to delete a user's account.
+// Verifies the user's given password against the hash in the database, and deletes the user if passwords match.
+// A user that does not exist has already been deleted, so no error is returned and the request can be retried.
+func (h *Handler) DeleteAccount(ctx context.Context, userID int64, password string) error {
+	// retrieve the user
+	user, query, err := h.retrieveUserByID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// user was already deleted
+			h.logger.Info(
+				constants.InfoUserDoesNotExist,
+				zap.Int64(constants.UserID, userID),
+				zap.String(constants.Query, query),
+			)
+			return nil
+		}
+		// error occured when querying database
+		return &customErr.Error{
+			ErrorCode: constants.ErrorDatabaseQuery,
+			ErrorMsg:  constants.ErrorDatabaseQueryMsg,
+		}
+	}
+
+	// check that the given password and stored password match
+	err = h.checkPasswordMatch(user.Password, password)
+	if err != nil {
+		// user password error
+		h.logger.Info(
+			constants.ErrorUserPasswordMsg,
+			zap.Int64(constants.UserID, userID),
+			zap.Error(err),
+		)
+		return &customErr.Error{
+			ErrorCode: constants.ErrorUserPassword,
+		}
+	}
+
+	query = "DELETE FROM users WHERE userID=?"
+	_, err = h.dbManager.DeleteOne(ctx, query, constants.DeleteUser, userID)
+	if err != nil {
+		// error occured when deleting user from database
+		return &customErr.Error{
+			ErrorCode: constants.ErrorDatabaseDelete,
+			ErrorMsg:  constants.ErrorDatabaseDeleteMsg,
+		}
+	}
+
+	h.logger.Info(
+		constants.InfoUserDeleted,
+		zap.String(constants.Username, user.Username),
+		zap.Int64(constants.UserID, userID),
+	)
+	return nil
 }
 
 // checkUserExists is a helper function.
@@ -155,18 +236,30 @@ func (h *Handler) checkUserExists(ctx context.Context, username string) (bool, d
 func (h *Handler) retrieveUserByUsername(ctx context.Context, username string) (db.User, string, error) {
 	var user db.User
 
-	query := fmt.Sprintf("SELECT * FROM users WHERE username='%s'", username)
-	err := h.dbManager.QueryOne(ctx, query, constants.GetUserByUsername, &user.UserID, &user.Username, &user.Password)
+	query := "SELECT * FROM users WHERE username=?"
+	err := h.dbManager.QueryOne(ctx, query, constants.GetUserByUsername, []any{username}, &user.UserID, &user.Username, &user.Password)
 	// err := res.Scan(&user.UserID, &user.Username, &user.Password)
 
 	return user, query, err
 }
 
+// retrieveUserByID is a helper function that retrieves users from the database based on userID.
+// Returns the user if succesful, else returns an error.
+// Also returns the query used for logging purposes.
+func (h *Handler) retrieveUserByID(ctx context.Context, userID int64) (db.User, string, error) {
+	var user db.User
+
+	query := "SELECT * FROM users WHERE userID=?"
+	err := h.dbManager.QueryOne(ctx, query, constants.GetUserByID, []any{userID}, &user.UserID, &user.Username, &user.Password)
+
+	return user, query, err
+}
+
 // insertNewUser is a helper function to insert a new user into the database. It returs the last inserted ID, as well as an error if any.
 func (h *Handler) insertNewUser(ctx context.Context, username string, hash []byte) (int64, error) {
-	query := fmt.Sprintf("INSERT INTO users(username, password) VALUES ('%s', '%s')", username, hash)
+	query := "INSERT INTO users(username, password) VALUES (?, ?)"
 
-	id, err := h.dbManager.InsertRow(ctx, query, constants.AddUser)
+	id, err := h.dbManager.InsertRow(ctx, query, constants.AddUser, username, hash)
 	if err != nil {
 		// error occured when inserting user into database
 		return 0, &customErr.Error{
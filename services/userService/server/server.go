@@ -3,12 +3,15 @@ package server
 import (
 	"context"
 	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"strconv"
 	"userService/config"
 	constants "userService/constants"
 	"userService/db"
+	"userService/keyring"
+	"userService/lockout"
 	"userService/tracing"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -29,8 +32,15 @@ import (
 )
 
 const (
-	grpcSignup = "server.Signup"
-	grpcLogin  = "server.Login"
+	grpcSignup    = "server.Signup"
+	grpcLogin     = "server.Login"
+	grpcDelete    = "server.DeleteAccount"
+	grpcRefresh   = "server.RefreshSession"
+	grpcRevoke    = "server.RevokeSession"
+	grpcRevokeAll = "server.RevokeAllSessions"
+	grpcCheck     = "server.CheckSession"
+	grpcValidate  = "server.ValidateToken"
+	grpcGetJWKS   = "server.GetJWKS"
 )
 
 // Server struct contains a reference to the handler. Used to start the grpc server.
@@ -42,10 +52,12 @@ type Server struct {
 }
 
 // StartServer initialises the prometheus metrics, starts the HTTP server for the prometheus endpoint and starts the GRPC server.
-func (s *Server) StartServer(config *config.Config, dbManager *db.DatabaseManager, logger *zap.Logger, tracer ot.Tracer) {
+func (s *Server) StartServer(config *config.Config, dbManager *db.DatabaseManager, keys *keyring.KeyRing, guard *lockout.Guard, logger *zap.Logger, tracer ot.Tracer) {
 	s.handler = Handler{
 		config:    config,
 		dbManager: dbManager,
+		keys:      keys,
+		guard:     guard,
 		logger:    logger,
 	}
 	s.logger = logger
@@ -177,7 +189,33 @@ func (s *Server) Login(ctx context.Context, req *pb.LoginReq) (*pb.LoginRes, err
 	var userID int64
 
 	// check if a user with the given username exists
-	userID, err := s.handler.VerifyLogin(ctx, req.Username, req.Password)
+	userID, retryAfter, err := s.handler.VerifyLogin(ctx, req.Username, req.Password, req.ClientIP)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.LoginRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.LoginRes{
+			ErrorCode: v.ErrorCode,
+			ErrorMsg:  v.ErrorMsg,
+			// round up, so that a retry after this many seconds is allowed
+			RetryAfter: int64(math.Ceil(retryAfter.Seconds())),
+		}, nil
+	}
+
+	// start a new session for the user
+	sessionID, refreshToken, refreshExpiresAt, err := s.handler.CreateSession(ctx, userID)
+	var token string
+	var tokenExpiresAt int64
+	if err == nil {
+		// issue the session's first token
+		token, tokenExpiresAt, err = s.handler.IssueToken(userID, sessionID)
+	}
 	if err != nil {
 		v, ok := err.(*customErr.Error)
 		if !ok {
@@ -194,8 +232,303 @@ func (s *Server) Login(ctx context.Context, req *pb.LoginReq) (*pb.LoginRes, err
 	}
 
 	return &pb.LoginRes{
+		ErrorCode:        -1,
+		UserID:           userID,
+		SessionID:        sessionID,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
+		Token:            token,
+		TokenExpiresAt:   tokenExpiresAt,
+	}, nil
+}
+
+// DeleteAccount is the implementation of the grpc server service, as defined in service.proto
+func (s *Server) DeleteAccount(ctx context.Context, req *pb.DeleteAccountReq) (*pb.DeleteAccountRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, grpcDelete)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := "-1"
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.DeleteAccount, errorCodeStr).Observe(v)
+	}))
+
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	err := s.handler.DeleteAccount(ctx, req.UserID, req.Password)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.DeleteAccountRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.DeleteAccountRes{
+			ErrorCode: v.ErrorCode,
+		}, nil
+	}
+
+	return &pb.DeleteAccountRes{
+		ErrorCode: -1,
+	}, nil
+}
+
+// RefreshSession is the implementation of the grpc server service, as defined in service.proto
+func (s *Server) RefreshSession(ctx context.Context, req *pb.RefreshSessionReq) (*pb.RefreshSessionRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, grpcRefresh)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := "-1"
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.RefreshSession, errorCodeStr).Observe(v)
+	}))
+
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	userID, sessionID, refreshToken, refreshExpiresAt, err := s.handler.RefreshSession(ctx, req.RefreshToken)
+	var token string
+	var tokenExpiresAt int64
+	if err == nil {
+		// issue a new token for the refreshed session
+		token, tokenExpiresAt, err = s.handler.IssueToken(userID, sessionID)
+	}
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.RefreshSessionRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.RefreshSessionRes{
+			ErrorCode: v.ErrorCode,
+			ErrorMsg:  v.ErrorMsg,
+		}, nil
+	}
+
+	return &pb.RefreshSessionRes{
+		ErrorCode:        -1,
+		UserID:           userID,
+		SessionID:        sessionID,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
+		Token:            token,
+		TokenExpiresAt:   tokenExpiresAt,
+	}, nil
+}
+
+// RevokeSession is the implementation of the grpc server service, as defined in service.proto
+func (s *Server) RevokeSession(ctx context.Context, req *pb.RevokeSessionReq) (*pb.RevokeSessionRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, grpcRevoke)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := "-1"
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.RevokeSession, errorCodeStr).Observe(v)
+	}))
+
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	err := s.handler.RevokeSession(ctx, req.UserID, req.SessionID)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.RevokeSessionRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.RevokeSessionRes{
+			ErrorCode: v.ErrorCode,
+			ErrorMsg:  v.ErrorMsg,
+		}, nil
+	}
+
+	return &pb.RevokeSessionRes{
+		ErrorCode: -1,
+	}, nil
+}
+
+// RevokeAllSessions is the implementation of the grpc server service, as defined in service.proto
+func (s *Server) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsReq) (*pb.RevokeAllSessionsRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, grpcRevokeAll)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := "-1"
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.RevokeAllSessions, errorCodeStr).Observe(v)
+	}))
+
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	revoked, err := s.handler.RevokeAllSessions(ctx, req.UserID)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.RevokeAllSessionsRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.RevokeAllSessionsRes{
+			ErrorCode: v.ErrorCode,
+			ErrorMsg:  v.ErrorMsg,
+		}, nil
+	}
+
+	return &pb.RevokeAllSessionsRes{
+		ErrorCode: -1,
+		Revoked:   revoked,
+	}, nil
+}
+
+// CheckSession is the implementation of the grpc server service, as defined in service.proto
+func (s *Server) CheckSession(ctx context.Context, req *pb.CheckSessionReq) (*pb.CheckSessionRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, grpcCheck)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := "-1"
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.CheckSession, errorCodeStr).Observe(v)
+	}))
+
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	userID, active, err := s.handler.CheckSession(ctx, req.SessionID)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.CheckSessionRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.CheckSessionRes{
+			ErrorCode: v.ErrorCode,
+			ErrorMsg:  v.ErrorMsg,
+		}, nil
+	}
+
+	return &pb.CheckSessionRes{
 		ErrorCode: -1,
 		UserID:    userID,
+		Active:    active,
+	}, nil
+}
+
+// ValidateToken is the implementation of the grpc server service, as defined in service.proto
+func (s *Server) ValidateToken(ctx context.Context, req *pb.ValidateTokenReq) (*pb.ValidateTokenRes, error) {
+	// start tracing span from context
+	span, ctx := ot.StartSpanFromContext(ctx, grpcValidate)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	errorCodeStr := "-1"
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.ValidateToken, errorCodeStr).Observe(v)
+	}))
+
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	claims, err := s.handler.ValidateToken(ctx, req.Token)
+	if err != nil {
+		v, ok := err.(*customErr.Error)
+		if !ok {
+			s.logger.Error(constants.ErrorTypecastMsg, zap.Error(err))
+			errorCodeStr = strconv.Itoa(constants.ErrorTypecast)
+			return &pb.ValidateTokenRes{
+				ErrorCode: constants.ErrorTypecast,
+			}, nil
+		}
+		errorCodeStr = strconv.Itoa(int(v.ErrorCode))
+		return &pb.ValidateTokenRes{
+			ErrorCode: v.ErrorCode,
+			ErrorMsg:  v.ErrorMsg,
+		}, nil
+	}
+
+	userID, _ := strconv.ParseInt(claims.UserID, 10, 64)
+	return &pb.ValidateTokenRes{
+		ErrorCode: -1,
+		Active:    true,
+		UserID:    userID,
+		SessionID: claims.SessionID,
+		TokenID:   claims.Id,
+		ExpiresAt: claims.ExpiresAt,
+	}, nil
+}
+
+// GetJWKS is the implementation of the grpc server service, as defined in service.proto
+func (s *Server) GetJWKS(ctx context.Context, req *pb.GetJWKSReq) (*pb.GetJWKSRes, error) {
+	// start tracing span from context
+	span, _ := ot.StartSpanFromContext(ctx, grpcGetJWKS)
+	s.addSpanTags(span)
+	defer span.Finish()
+
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
+		metrics.RequestDuration.WithLabelValues(s.config.ServiceLabel, constants.GetJWKS, constants.NilErrorCode).Observe(v)
+	}))
+
+	// observe duration at the end of this function
+	defer func() {
+		timer.ObserveDuration()
+	}()
+
+	jwks := s.handler.keys.JWKS()
+	keys := make([]*pb.JWK, len(jwks.Keys))
+	for i, key := range jwks.Keys {
+		keys[i] = &pb.JWK{
+			Kty: key.KeyType,
+			Kid: key.KeyID,
+			Alg: key.Algorithm,
+			Use: key.Use,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Curve,
+			X:   key.X,
+		}
+	}
+	return &pb.GetJWKSRes{
+		ErrorCode: -1,
+		Keys:      keys,
 	}, nil
 }
 
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+	constants "userService/constants"
+	db "userService/db"
+	customErr "userService/errors"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// sessionIDBytes is the number of random bytes in a session ID
+	sessionIDBytes = 16
+	// refreshSecretBytes is the number of random bytes in the secret part of a refresh token
+	refreshSecretBytes = 32
+	// refreshTokenSeparator separates the session ID and the secret in a refresh token
+	refreshTokenSeparator = "."
+)
+
+// CreateSession is called by the server to start a new session for the user during Login.
+// It returns the session ID, the session's refresh token, and the time the session expires in unix seconds.
+func (h *Handler) CreateSession(ctx context.Context, userID int64) (string, string, int64, error) {
+	sessionID, err := randomHex(sessionIDBytes)
+	if err != nil {
+		h.logger.Error(constants.ErrorGenerateTokenMsg, zap.Error(err))
+		return "", "", 0, &customErr.Error{ErrorCode: constants.ErrorGenerateToken, ErrorMsg: constants.ErrorGenerateTokenMsg}
+	}
+	secret, err := randomSecret()
+	if err != nil {
+		h.logger.Error(constants.ErrorGenerateTokenMsg, zap.Error(err))
+		return "", "", 0, &customErr.Error{ErrorCode: constants.ErrorGenerateToken, ErrorMsg: constants.ErrorGenerateTokenMsg}
+	}
+	expiresAt := h.sessionExpiry()
+
+	query := "INSERT INTO sessions(sessionID, userID, refreshHash, expiresAt) VALUES (?, ?, ?, ?)"
+	_, err = h.dbManager.InsertRow(ctx, query, constants.AddSession, sessionID, userID, hashSecret(secret), expiresAt)
+	if err != nil {
+		return "", "", 0, &customErr.Error{ErrorCode: constants.ErrorDatabaseInsert, ErrorMsg: constants.ErrorDatabaseInsertMsg}
+	}
+
+	h.logger.Info(
+		constants.InfoSessionCreated,
+		zap.Int64(constants.UserID, userID),
+		zap.String(constants.SessionID, sessionID),
+	)
+	return sessionID, sessionID + refreshTokenSeparator + secret, expiresAt, nil
+}
+
+// RefreshSession is called by the server when it receives a request to refresh a session.
+// The refresh token is replaced by a new one, and the session's expiry is extended.
+// A refresh token that was already replaced has been reused, possibly by an attacker, so the session is revoked.
+// It returns the session's userID and session ID, the new refresh token, and the time the session expires in unix seconds.
+func (h *Handler) RefreshSession(ctx context.Context, refreshToken string) (int64, string, string, int64, error) {
+	sessionID, secret, ok := strings.Cut(refreshToken, refreshTokenSeparator)
+	if !ok || sessionID == "" || secret == "" {
+		return 0, "", "", 0, &customErr.Error{ErrorCode: constants.ErrorRefreshTokenInvalid, ErrorMsg: constants.ErrorRefreshTokenInvalidMsg}
+	}
+
+	session, err := h.retrieveSession(ctx, sessionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, "", "", 0, &customErr.Error{ErrorCode: constants.ErrorRefreshTokenInvalid, ErrorMsg: constants.ErrorRefreshTokenInvalidMsg}
+		}
+		return 0, "", "", 0, &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg}
+	}
+	if session.Revoked {
+		return 0, "", "", 0, &customErr.Error{ErrorCode: constants.ErrorSessionRevoked, ErrorMsg: constants.ErrorSessionRevokedMsg}
+	}
+
+	hash := hashSecret(secret)
+	if subtle.ConstantTimeCompare(hash, session.RefreshHash) != 1 {
+		return 0, "", "", 0, h.revokeReusedSession(ctx, session)
+	}
+	if session.ExpiresAt <= time.Now().Unix() {
+		return 0, "", "", 0, &customErr.Error{ErrorCode: constants.ErrorRefreshTokenExpired, ErrorMsg: constants.ErrorRefreshTokenExpiredMsg}
+	}
+
+	newSecret, err := randomSecret()
+	if err != nil {
+		h.logger.Error(constants.ErrorGenerateTokenMsg, zap.Error(err))
+		return 0, "", "", 0, &customErr.Error{ErrorCode: constants.ErrorGenerateToken, ErrorMsg: constants.ErrorGenerateTokenMsg}
+	}
+	expiresAt := h.sessionExpiry()
+
+	// the old hash is matched again, so that only one of two concurrent refreshes with the same token succeeds
+	query := "UPDATE sessions SET refreshHash=?, expiresAt=? WHERE sessionID=? AND refreshHash=? AND revoked=false"
+	rows, err := h.dbManager.UpdateRows(ctx, query, constants.RotateSession, hashSecret(newSecret), expiresAt, sessionID, hash)
+	if err != nil {
+		return 0, "", "", 0, &customErr.Error{ErrorCode: constants.ErrorDatabaseUpdate, ErrorMsg: constants.ErrorDatabaseUpdateMsg}
+	}
+	if rows == 0 {
+		return 0, "", "", 0, h.revokeReusedSession(ctx, session)
+	}
+
+	h.logger.Info(
+		constants.InfoSessionRefreshed,
+		zap.Int64(constants.UserID, session.UserID),
+		zap.String(constants.SessionID, sessionID),
+	)
+	return session.UserID, sessionID, sessionID + refreshTokenSeparator + newSecret, expiresAt, nil
+}
+
+// RevokeSession is called by the server when the user logs out of a session.
+// Revoking a session that is already revoked succeeds.
+func (h *Handler) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	query := "UPDATE sessions SET revoked=true WHERE sessionID=? AND userID=?"
+	_, err := h.dbManager.UpdateRows(ctx, query, constants.RevokeSession, sessionID, userID)
+	if err != nil {
+		return &customErr.Error{ErrorCode: constants.ErrorDatabaseUpdate, ErrorMsg: constants.ErrorDatabaseUpdateMsg}
+	}
+
+	h.logger.Info(
+		constants.InfoSessionRevoked,
+		zap.Int64(constants.UserID, userID),
+		zap.String(constants.SessionID, sessionID),
+	)
+	return nil
+}
+
+// RevokeAllSessions is called by the server when the user logs out of every session.
+// It returns the number of sessions revoked.
+func (h *Handler) RevokeAllSessions(ctx context.Context, userID int64) (int64, error) {
+	query := "UPDATE sessions SET revoked=true WHERE userID=? AND revoked=false"
+	revoked, err := h.dbManager.UpdateRows(ctx, query, constants.RevokeAllSessions, userID)
+	if err != nil {
+		return 0, &customErr.Error{ErrorCode: constants.ErrorDatabaseUpdate, ErrorMsg: constants.ErrorDatabaseUpdateMsg}
+	}
+
+	h.logger.Info(
+		constants.InfoAllSessionsRevoked,
+		zap.Int64(constants.UserID, userID),
+		zap.Int64(constants.Count, revoked),
+	)
+	return revoked, nil
+}
+
+// CheckSession is called by the server to check whether the session an access token was issued for is still active.
+// It returns the session's userID, and whether the session exists and is neither revoked nor expired.
+func (h *Handler) CheckSession(ctx context.Context, sessionID string) (int64, bool, error) {
+	session, err := h.retrieveSession(ctx, sessionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, false, nil
+		}
+		return 0, false, &customErr.Error{ErrorCode: constants.ErrorDatabaseQuery, ErrorMsg: constants.ErrorDatabaseQueryMsg}
+	}
+	return session.UserID, !session.Revoked && session.ExpiresAt > time.Now().Unix(), nil
+}
+
+// revokeReusedSession is a helper function that revokes a session whose refresh token was reused, and returns the error to send back.
+func (h *Handler) revokeReusedSession(ctx context.Context, session db.Session) error {
+	h.logger.Warn(
+		constants.ErrorRefreshTokenReusedMsg,
+		zap.Int64(constants.UserID, session.UserID),
+		zap.String(constants.SessionID, session.SessionID),
+	)
+	if err := h.RevokeSession(ctx, session.UserID, session.SessionID); err != nil {
+		return err
+	}
+	return &customErr.Error{ErrorCode: constants.ErrorRefreshTokenReused, ErrorMsg: constants.ErrorRefreshTokenReusedMsg}
+}
+
+// retrieveSession is a helper function that retrieves a session from the database based on session ID.
+func (h *Handler) retrieveSession(ctx context.Context, sessionID string) (db.Session, error) {
+	var session db.Session
+
+	query := "SELECT sessionID, userID, refreshHash, expiresAt, revoked FROM sessions WHERE sessionID=?"
+	err := h.dbManager.QueryOne(ctx, query, constants.GetSession, []any{sessionID}, &session.SessionID, &session.UserID, &session.RefreshHash, &session.ExpiresAt, &session.Revoked)
+
+	return session, err
+}
+
+// sessionExpiry returns the time in unix seconds that a session created or refreshed now expires.
+func (h *Handler) sessionExpiry() int64 {
+	return time.Now().Add(time.Duration(h.config.RefreshExpiry) * time.Minute).Unix()
+}
+
+// randomHex returns n random bytes encoded as hex.
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// randomSecret returns the random secret part of a refresh token.
+func randomSecret() (string, error) {
+	b := make([]byte, refreshSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashSecret returns the hash of a refresh token's secret that is stored in the database.
+func hashSecret(secret string) []byte {
+	hash := sha256.Sum256([]byte(secret))
+	return hash[:]
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"time"
+	constants "userService/constants"
+	customErr "userService/errors"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+// tokenIDBytes is the number of random bytes in a token ID
+const tokenIDBytes = 16
+
+// Claims is a struct that will be encoded to a JWT.
+// SessionID is the session the token was issued for, and the token's ID and expiry are set in jwt.StandardClaims.
+type Claims struct {
+	UserID    string `json:"userID"`
+	SessionID string `json:"sid"`
+	jwt.StandardClaims
+}
+
+// IssueToken is called by the server to issue a token for the user's session, signed by the signing key of the key ring.
+// It returns the token and the time the token expires in unix seconds.
+func (h *Handler) IssueToken(userID int64, sessionID string) (string, int64, error) {
+	tokenID, err := randomHex(tokenIDBytes)
+	if err != nil {
+		h.logger.Error(constants.ErrorGenerateTokenMsg, zap.Error(err))
+		return "", 0, &customErr.Error{ErrorCode: constants.ErrorGenerateToken, ErrorMsg: constants.ErrorGenerateTokenMsg}
+	}
+	now := time.Now()
+	expiresAt := now.Add(time.Duration(h.config.TokenExpiry) * time.Minute).Unix()
+	claims := &Claims{
+		UserID:    strconv.FormatInt(userID, 10),
+		SessionID: sessionID,
+		StandardClaims: jwt.StandardClaims{
+			Id:        tokenID,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: expiresAt,
+		},
+	}
+
+	token, err := h.keys.Sign(claims)
+	if err != nil {
+		h.logger.Error(constants.ErrorGenerateTokenMsg, zap.Error(err))
+		return "", 0, &customErr.Error{ErrorCode: constants.ErrorGenerateToken, ErrorMsg: constants.ErrorGenerateTokenMsg}
+	}
+	return token, expiresAt, nil
+}
+
+// ValidateToken is called by the server when it receives a request to introspect a token.
+// It verifies the token's signature and claims, and checks that the session the token was issued for is still active.
+// It returns the token's claims if the token is valid.
+func (h *Handler) ValidateToken(ctx context.Context, tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, h.keys.Keyfunc, jwt.WithValidMethods(h.keys.Methods()))
+	if err != nil || !token.Valid {
+		h.logger.Info(constants.ErrorTokenInvalidMsg, zap.Error(err))
+		return nil, &customErr.Error{ErrorCode: constants.ErrorTokenInvalid, ErrorMsg: constants.ErrorTokenInvalidMsg}
+	}
+
+	userID, active, err := h.CheckSession(ctx, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !active || strconv.FormatInt(userID, 10) != claims.UserID {
+		return nil, &customErr.Error{ErrorCode: constants.ErrorSessionRevoked, ErrorMsg: constants.ErrorSessionRevokedMsg}
+	}
+	return claims, nil
+}